@@ -0,0 +1,153 @@
+package kripto
+
+import "crypto/cipher"
+
+// CBCBlockState describes how a block went through the CBC mode, to debug attacks.
+//
+// Encrypting: Intermediate = Input ^ Prev and Output = Encrypt(Intermediate).
+// Decrypting: Intermediate = Decrypt(Input) and Output = Intermediate ^ Prev.
+// The decryption intermediate state is what padding oracle and bit flipping attacks work on:
+// the attacker controls Prev and thus the resulting plaintext.
+type CBCBlockState struct {
+	// Index of the block in the data
+	Index int
+	// Input is the plaintext block when encrypting, the ciphertext block when decrypting.
+	Input []byte
+	// Prev is the previous ciphertext block, or the IV for the first block.
+	Prev []byte
+	// Intermediate is the block cipher input when encrypting, its output when decrypting.
+	Intermediate []byte
+	// Output is the ciphertext block when encrypting, the plaintext block when decrypting.
+	Output []byte
+}
+
+// CBCTraceFn is called with the state of each block going through a CBC mode.
+type CBCTraceFn func(state *CBCBlockState)
+
+type cbc struct {
+	b         cipher.Block
+	blockSize int
+	iv        []byte
+	trace     CBCTraceFn
+}
+
+func newCBC(b cipher.Block, iv []byte, trace CBCTraceFn) *cbc {
+	if len(iv) != b.BlockSize() {
+		panic("kripto: IV length must equal block size")
+	}
+	return &cbc{
+		b:         b,
+		blockSize: b.BlockSize(),
+		iv:        append([]byte{}, iv...),
+		trace:     trace,
+	}
+}
+
+type cbcEncrypter cbc
+
+// NewCBCEncrypter returns a BlockMode which encrypts in cipher block chaining
+// mode, using the given Block. Like the crypto/cipher one, the chaining continues
+// between calls to CryptBlocks. The length of iv must be the same as the Block's block size.
+func NewCBCEncrypter(b cipher.Block, iv []byte) cipher.BlockMode {
+	return (*cbcEncrypter)(newCBC(b, iv, nil))
+}
+
+// NewCBCEncrypterWithTrace works like NewCBCEncrypter, calling trace with the state of each encrypted block.
+func NewCBCEncrypterWithTrace(b cipher.Block, iv []byte, trace CBCTraceFn) cipher.BlockMode {
+	return (*cbcEncrypter)(newCBC(b, iv, trace))
+}
+
+func (x *cbcEncrypter) BlockSize() int { return x.blockSize }
+
+func (x *cbcEncrypter) CryptBlocks(dst, src []byte) {
+	if len(src)%x.blockSize != 0 {
+		panic("crypto/cipher: input not full blocks")
+	}
+	if len(dst) < len(src) {
+		panic("crypto/cipher: output smaller than input")
+	}
+	for i := 0; len(src) > 0; i++ {
+		intermediate := MultiCharXor(src[:x.blockSize], x.iv)
+		var state *CBCBlockState
+		if x.trace != nil {
+			// dst and src can overlap
+			state = &CBCBlockState{
+				Index:        i,
+				Input:        append([]byte{}, src[:x.blockSize]...),
+				Prev:         append([]byte{}, x.iv...),
+				Intermediate: intermediate,
+			}
+		}
+		x.b.Encrypt(dst, intermediate)
+		copy(x.iv, dst[:x.blockSize])
+		if state != nil {
+			state.Output = append([]byte{}, dst[:x.blockSize]...)
+			x.trace(state)
+		}
+		src = src[x.blockSize:]
+		dst = dst[x.blockSize:]
+	}
+}
+
+type cbcDecrypter cbc
+
+// NewCBCDecrypter returns a BlockMode which decrypts in cipher block chaining
+// mode, using the given Block. The length of iv must be the same as the Block's block size.
+func NewCBCDecrypter(b cipher.Block, iv []byte) cipher.BlockMode {
+	return (*cbcDecrypter)(newCBC(b, iv, nil))
+}
+
+// NewCBCDecrypterWithTrace works like NewCBCDecrypter, calling trace with the state of each decrypted block.
+func NewCBCDecrypterWithTrace(b cipher.Block, iv []byte, trace CBCTraceFn) cipher.BlockMode {
+	return (*cbcDecrypter)(newCBC(b, iv, trace))
+}
+
+func (x *cbcDecrypter) BlockSize() int { return x.blockSize }
+
+func (x *cbcDecrypter) CryptBlocks(dst, src []byte) {
+	if len(src)%x.blockSize != 0 {
+		panic("crypto/cipher: input not full blocks")
+	}
+	if len(dst) < len(src) {
+		panic("crypto/cipher: output smaller than input")
+	}
+	intermediate := make([]byte, x.blockSize)
+	for i := 0; len(src) > 0; i++ {
+		// keep the ciphertext block, dst and src can overlap
+		block := append([]byte{}, src[:x.blockSize]...)
+		x.b.Decrypt(intermediate, block)
+		plain := MultiCharXor(intermediate, x.iv)
+		if x.trace != nil {
+			x.trace(&CBCBlockState{
+				Index:        i,
+				Input:        block,
+				Prev:         append([]byte{}, x.iv...),
+				Intermediate: append([]byte{}, intermediate...),
+				Output:       plain,
+			})
+		}
+		copy(dst, plain)
+		copy(x.iv, block)
+		src = src[x.blockSize:]
+		dst = dst[x.blockSize:]
+	}
+}
+
+// CBCEncrypt pads the plaintext using PKCS#7 and encrypts it in CBC mode.
+// Unlike the BlockMode returned by NewCBCEncrypter, it accepts any plaintext length.
+func CBCEncrypt(b cipher.Block, iv, plaintext []byte) []byte {
+	data := pkcs7Pad(append([]byte{}, plaintext...), b.BlockSize())
+	NewCBCEncrypter(b, iv).CryptBlocks(data, data)
+	return data
+}
+
+// CBCDecrypt decrypts the ciphertext in CBC mode and removes its PKCS#7 padding,
+// returning the PKCS7Unpad errors (ErrPaddingLength if the ciphertext isn't made of full blocks).
+func CBCDecrypt(b cipher.Block, iv, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) == 0 || len(ciphertext)%b.BlockSize() != 0 {
+		return nil, ErrPaddingLength
+	}
+	data := make([]byte, len(ciphertext))
+	NewCBCDecrypter(b, iv).CryptBlocks(data, ciphertext)
+	return PKCS7Unpad(data, b.BlockSize())
+}
@@ -0,0 +1,163 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"testing"
+)
+
+var cbcAESTests = []struct {
+	name string
+	key  []byte
+	iv   []byte
+	in   []byte
+	out  []byte
+}{
+	// NIST SP 800-38A pp 27-29
+	{
+		"CBC-AES128",
+		commonKey128,
+		commonIV,
+		commonInput,
+		[]byte{
+			0x76, 0x49, 0xab, 0xac, 0x81, 0x19, 0xb2, 0x46, 0xce, 0xe9, 0x8e, 0x9b, 0x12, 0xe9, 0x19, 0x7d,
+			0x50, 0x86, 0xcb, 0x9b, 0x50, 0x72, 0x19, 0xee, 0x95, 0xdb, 0x11, 0x3a, 0x91, 0x76, 0x78, 0xb2,
+			0x73, 0xbe, 0xd6, 0xb8, 0xe3, 0xc1, 0x74, 0x3b, 0x71, 0x16, 0xe6, 0x9e, 0x22, 0x22, 0x95, 0x16,
+			0x3f, 0xf1, 0xca, 0xa1, 0x68, 0x1f, 0xac, 0x09, 0x12, 0x0e, 0xca, 0x30, 0x75, 0x86, 0xe1, 0xa7,
+		},
+	},
+	{
+		"CBC-AES192",
+		commonKey192,
+		commonIV,
+		commonInput,
+		[]byte{
+			0x4f, 0x02, 0x1d, 0xb2, 0x43, 0xbc, 0x63, 0x3d, 0x71, 0x78, 0x18, 0x3a, 0x9f, 0xa0, 0x71, 0xe8,
+			0xb4, 0xd9, 0xad, 0xa9, 0xad, 0x7d, 0xed, 0xf4, 0xe5, 0xe7, 0x38, 0x76, 0x3f, 0x69, 0x14, 0x5a,
+			0x57, 0x1b, 0x24, 0x20, 0x12, 0xfb, 0x7a, 0xe0, 0x7f, 0xa9, 0xba, 0xac, 0x3d, 0xf1, 0x02, 0xe0,
+			0x08, 0xb0, 0xe2, 0x79, 0x88, 0x59, 0x88, 0x81, 0xd9, 0x20, 0xa9, 0xe6, 0x4f, 0x56, 0x15, 0xcd,
+		},
+	},
+	{
+		"CBC-AES256",
+		commonKey256,
+		commonIV,
+		commonInput,
+		[]byte{
+			0xf5, 0x8c, 0x4c, 0x04, 0xd6, 0xe5, 0xf1, 0xba, 0x77, 0x9e, 0xab, 0xfb, 0x5f, 0x7b, 0xfb, 0xd6,
+			0x9c, 0xfc, 0x4e, 0x96, 0x7e, 0xdb, 0x80, 0x8d, 0x67, 0x9f, 0x77, 0x7b, 0xc6, 0x70, 0x2c, 0x7d,
+			0x39, 0xf2, 0x33, 0x69, 0xa9, 0xd9, 0xba, 0xcf, 0xa5, 0x30, 0xe2, 0x63, 0x04, 0x23, 0x14, 0x61,
+			0xb2, 0xeb, 0x05, 0xe2, 0xc3, 0x9b, 0xe9, 0xfc, 0xda, 0x6c, 0x19, 0x07, 0x8c, 0x6a, 0x9d, 0x1b,
+		},
+	},
+}
+
+func TestCBC_AES(t *testing.T) {
+	for _, tt := range cbcAESTests {
+		test := tt.name
+
+		c, err := aes.NewCipher(tt.key)
+		if err != nil {
+			t.Errorf("%s: NewCipher(%d bytes) = %s", test, len(tt.key), err)
+			continue
+		}
+
+		encrypter := NewCBCEncrypter(c, tt.iv)
+		d := make([]byte, len(tt.in))
+		encrypter.CryptBlocks(d, tt.in)
+		if !bytes.Equal(tt.out, d) {
+			t.Errorf("%s: CBCEncrypter\nhave %x\nwant %x", test, d, tt.out)
+		}
+
+		decrypter := NewCBCDecrypter(c, tt.iv)
+		p := make([]byte, len(d))
+		decrypter.CryptBlocks(p, d)
+		if !bytes.Equal(tt.in, p) {
+			t.Errorf("%s: CBCDecrypter\nhave %x\nwant %x", test, p, tt.in)
+		}
+	}
+}
+
+func TestCBCStandardLibrary(t *testing.T) {
+	c, err := aes.NewCipher(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := bytes.Repeat(commonInput, 3)
+
+	expected := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(c, commonIV).CryptBlocks(expected, plaintext)
+
+	// the chaining continues between calls and works in place
+	d := append([]byte{}, plaintext...)
+	encrypter := NewCBCEncrypter(c, commonIV)
+	encrypter.CryptBlocks(d[:48], d[:48])
+	encrypter.CryptBlocks(d[48:], d[48:])
+	if !bytes.Equal(expected, d) {
+		t.Fatalf("CBCEncrypter\nhave %x\nwant %x", d, expected)
+	}
+
+	decrypter := NewCBCDecrypter(c, commonIV)
+	decrypter.CryptBlocks(d[:32], d[:32])
+	decrypter.CryptBlocks(d[32:], d[32:])
+	if !bytes.Equal(plaintext, d) {
+		t.Fatalf("CBCDecrypter\nhave %x\nwant %x", d, plaintext)
+	}
+}
+
+func TestCBCTrace(t *testing.T) {
+	c, err := aes.NewCipher(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext := cbcAESTests[0].out
+
+	var states []*CBCBlockState
+	trace := func(state *CBCBlockState) {
+		states = append(states, state)
+	}
+	p := make([]byte, len(ciphertext))
+	NewCBCDecrypterWithTrace(c, commonIV, trace).CryptBlocks(p, ciphertext)
+	if len(states) != 4 {
+		t.Fatalf("expected the state of 4 blocks but got %d\n", len(states))
+	}
+	for i, state := range states {
+		prev := commonIV
+		if i > 0 {
+			prev = ciphertext[(i-1)*16 : i*16]
+		}
+		if state.Index != i || !bytes.Equal(state.Input, ciphertext[i*16:(i+1)*16]) || !bytes.Equal(state.Prev, prev) ||
+			!bytes.Equal(MultiCharXor(state.Intermediate, prev), commonInput[i*16:(i+1)*16]) || !bytes.Equal(state.Output, commonInput[i*16:(i+1)*16]) {
+			t.Fatalf("unexpected state for block %d: %+v\n", i, state)
+		}
+	}
+
+	states = nil
+	NewCBCEncrypterWithTrace(c, commonIV, trace).CryptBlocks(p, commonInput)
+	if len(states) != 4 {
+		t.Fatalf("expected the state of 4 blocks but got %d\n", len(states))
+	}
+	for i, state := range states {
+		if !bytes.Equal(state.Output, ciphertext[i*16:(i+1)*16]) || !bytes.Equal(state.Intermediate, MultiCharXor(state.Input, state.Prev)) {
+			t.Fatalf("unexpected state for block %d: %+v\n", i, state)
+		}
+	}
+}
+
+func TestCBCEncryptDecrypt(t *testing.T) {
+	c, err := aes.NewCipher(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{0, 1, 15, 16, 17, 64} {
+		plaintext := commonInput[:n]
+		ciphertext := CBCEncrypt(c, commonIV, plaintext)
+		if n == 64 && !bytes.Equal(ciphertext[:64], cbcAESTests[0].out) {
+			t.Fatalf("expected\n%x\ngot\n%x\n", cbcAESTests[0].out, ciphertext[:64])
+		}
+		if o, err := CBCDecrypt(c, commonIV, ciphertext); err != nil || !bytes.Equal(o, plaintext) {
+			t.Fatalf("expected\n%x\ngot\n%x (%v)\n", plaintext, o, err)
+		}
+	}
+}
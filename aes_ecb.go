@@ -1,6 +1,9 @@
 package kripto
 
-import "crypto/cipher"
+import (
+	"crypto/cipher"
+	"sort"
+)
 
 // Borrowed from rejected PR: https://codereview.appspot.com/7860047
 
@@ -66,3 +69,95 @@ func (x *ecbDecrypter) CryptBlocks(dst, src []byte) {
 		dst = dst[x.blockSize:]
 	}
 }
+
+// ECBScore returns the ratio of the blocks of the ciphertext that repeat a previous block.
+// Since ECB encrypts identical plaintext blocks to identical ciphertext blocks, a positive score
+// indicates the data was most likely encrypted in ECB mode (random data almost never repeats a block).
+// The trailing partial block, if any, is ignored.
+func ECBScore(ciphertext []byte, blockSize int) float64 {
+	if blockSize <= 0 {
+		panic("kripto: invalid block size")
+	}
+	blocks := len(ciphertext) / blockSize
+	if blocks == 0 {
+		return 0
+	}
+	seen := make(map[string]bool, blocks)
+	repeated := 0
+	for i := 0; i < blocks; i++ {
+		block := string(ciphertext[i*blockSize : (i+1)*blockSize])
+		if seen[block] {
+			repeated++
+		}
+		seen[block] = true
+	}
+	return float64(repeated) / float64(blocks)
+}
+
+// IsECB indicates if the ciphertext looks encrypted in ECB mode (it repeats at least one block).
+func IsECB(ciphertext []byte, blockSize int) bool {
+	return ECBScore(ciphertext, blockSize) > 0
+}
+
+// ECBCandidate is a ciphertext and the score ECBScore gave it.
+type ECBCandidate struct {
+	// Index is the position of the ciphertext in the slice given to RankECB
+	Index      int
+	Ciphertext []byte
+	Score      float64
+}
+
+// ECBCandidates is a collection of ECB candidates.
+type ECBCandidates []*ECBCandidate
+
+// Len implements the sort interface
+func (c ECBCandidates) Len() int {
+	return len(c)
+}
+
+// Swap implements the sort interface
+func (c ECBCandidates) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
+
+// Less implements the sort interface, best scores first and lower indexes first on ties.
+func (c ECBCandidates) Less(i, j int) bool {
+	if c[i].Score == c[j].Score {
+		return c[i].Index < c[j].Index
+	}
+	return c[i].Score > c[j].Score
+}
+
+// RankECB scores the ciphertexts using ECBScore and returns them from the most likely
+// to be ECB encrypted to the least likely.
+func RankECB(ciphertexts [][]byte, blockSize int) ECBCandidates {
+	candidates := make(ECBCandidates, len(ciphertexts))
+	for i, ciphertext := range ciphertexts {
+		candidates[i] = &ECBCandidate{
+			Index:      i,
+			Ciphertext: ciphertext,
+			Score:      ECBScore(ciphertext, blockSize),
+		}
+	}
+	sort.Sort(candidates)
+	return candidates
+}
+
+// ECBEncrypt pads the plaintext using PKCS#7 and encrypts it in ECB mode.
+// Unlike the BlockMode returned by NewECBEncrypter, it accepts any plaintext length.
+func ECBEncrypt(b cipher.Block, plaintext []byte) []byte {
+	data := pkcs7Pad(append([]byte{}, plaintext...), b.BlockSize())
+	NewECBEncrypter(b).CryptBlocks(data, data)
+	return data
+}
+
+// ECBDecrypt decrypts the ciphertext in ECB mode and removes its PKCS#7 padding,
+// returning the PKCS7Unpad errors (ErrPaddingLength if the ciphertext isn't made of full blocks).
+func ECBDecrypt(b cipher.Block, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) == 0 || len(ciphertext)%b.BlockSize() != 0 {
+		return nil, ErrPaddingLength
+	}
+	data := make([]byte, len(ciphertext))
+	NewECBDecrypter(b).CryptBlocks(data, ciphertext)
+	return PKCS7Unpad(data, b.BlockSize())
+}
@@ -3,8 +3,10 @@ package kripto
 import (
 	"bytes"
 	"crypto/aes"
+	"crypto/cipher"
 	"encoding/base64"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"testing"
 )
@@ -64,7 +66,7 @@ func TestECBDecrypter(t *testing.T) {
 	plaintext := make([]byte, len(ciphertext))
 	mode.CryptBlocks(plaintext, ciphertext)
 	if string(plaintext[:33]) != "I'm back and I'm ringin' the bell" {
-		t.Fatalf("ciphertext not properly decrypted, got", string(plaintext))
+		t.Fatalf("ciphertext not properly decrypted, got %s", string(plaintext))
 	}
 }
 
@@ -134,3 +136,59 @@ func TestECB_AES(t *testing.T) {
 		}
 	}
 }
+
+func TestECBScore(t *testing.T) {
+	testCases := []struct {
+		input     []byte
+		blockSize int
+		score     float64
+	}{
+		{nil, 16, 0},
+		{commonInput, 16, 0},
+		{bytes.Repeat(commonInput[:16], 4), 16, 0.75},
+		{append(bytes.Repeat(commonInput[:16], 2), commonInput...), 16, 2.0 / 6},
+		// the trailing partial block is ignored
+		{append(bytes.Repeat(commonInput[:8], 2), 1, 2, 3), 8, 0.5},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if o := ECBScore(tc.input, tc.blockSize); o != tc.score {
+			t.Fatalf("expected %f\ngot\n%f\n", tc.score, o)
+		}
+	}
+}
+
+func TestRankECB(t *testing.T) {
+	rnd := rand.New(rand.NewSource(8))
+	c, err := aes.NewCipher(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// English text encrypted in CBC mode, except for one line encrypted in ECB mode
+	plaintext, err := ioutil.ReadFile(fixturePath("english.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext = bytes.Repeat(plaintext[:160], 2)
+	ciphertexts := make([][]byte, 100)
+	for i := range ciphertexts {
+		ciphertexts[i] = make([]byte, len(plaintext))
+		if i == 42 {
+			NewECBEncrypter(c).CryptBlocks(ciphertexts[i], plaintext)
+			continue
+		}
+		iv := make([]byte, aes.BlockSize)
+		rnd.Read(iv)
+		cipher.NewCBCEncrypter(c, iv).CryptBlocks(ciphertexts[i], plaintext)
+	}
+
+	ranked := RankECB(ciphertexts, aes.BlockSize)
+	if ranked[0].Index != 42 || ranked[0].Score != 0.5 || !IsECB(ranked[0].Ciphertext, aes.BlockSize) {
+		t.Fatalf("expected ciphertext 42 to be detected as ECB but got %d (%f)\n", ranked[0].Index, ranked[0].Score)
+	}
+	if ranked[1].Score != 0 || IsECB(ranked[1].Ciphertext, aes.BlockSize) {
+		t.Fatalf("expected the CBC ciphertexts not to repeat blocks but %d got %f\n", ranked[1].Index, ranked[1].Score)
+	}
+}
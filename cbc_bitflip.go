@@ -0,0 +1,98 @@
+package kripto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+	"strings"
+)
+
+var (
+	// ErrBitFlipLength is returned when the known and desired plaintexts don't have the same length.
+	ErrBitFlipLength = errors.New("kripto: known and desired plaintexts must have the same length")
+	// ErrBitFlipRange is returned when the edited plaintext isn't within a single block
+	// preceded by another block of the ciphertext.
+	ErrBitFlipRange = errors.New("kripto: the edited plaintext must be within a single block following another one")
+)
+
+// CBCBitFlipMask returns the XOR mask turning the known plaintext into the desired one.
+func CBCBitFlipMask(known, desired []byte) ([]byte, error) {
+	if len(known) != len(desired) {
+		return nil, ErrBitFlipLength
+	}
+	return MultiCharXor(known, desired), nil
+}
+
+// CBCBitFlip returns a copy of the ciphertext edited so that the known plaintext at offset decrypts to the desired one
+// (https://cryptopals.com/sets/2/challenges/16).
+// In CBC mode each plaintext block is XORed with the previous ciphertext block, so XORing the block N with
+// the mask of the changes (see CBCBitFlipMask) applies them to the block N+1. The block N decrypts to garbage.
+// The edited plaintext must be within a single block and can't be in the first one, unless the IV is prepended
+// to the ciphertext (the offset then counts it).
+func CBCBitFlip(ciphertext []byte, blockSize, offset int, known, desired []byte) ([]byte, error) {
+	mask, err := CBCBitFlipMask(known, desired)
+	if err != nil {
+		return nil, err
+	}
+	end := offset + len(mask)
+	if offset < blockSize || end > len(ciphertext) || len(mask) > 0 && offset/blockSize != (end-1)/blockSize {
+		return nil, ErrBitFlipRange
+	}
+	out := append([]byte{}, ciphertext...)
+	for i, m := range mask {
+		out[offset-blockSize+i] ^= m
+	}
+	return out, nil
+}
+
+const (
+	userDataPrefix = "comment1=cooking%20MCs;userdata="
+	userDataSuffix = ";comment2=%20like%20a%20pound%20of%20bacon"
+)
+
+// UserDataService is a stand-in for a web service keeping user data in a cookie encrypted using AES in CBC mode
+// (with a random IV), a classic target for CBC bit flipping attacks.
+// The user data is quoted so that it can't inject ; and = to add an admin=true field to the cookie.
+type UserDataService struct {
+	block cipher.Block
+}
+
+// NewUserDataService returns a service encrypting its cookies using the AES key.
+func NewUserDataService(key []byte) (*UserDataService, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &UserDataService{block: block}, nil
+}
+
+// QuoteUserData escapes the ; and = characters (and the % escape character) of the user data.
+func QuoteUserData(userData string) string {
+	return strings.NewReplacer("%", "%25", ";", "%3B", "=", "%3D").Replace(userData)
+}
+
+// Cookie returns the IV and the encrypted cookie holding the quoted user data:
+// comment1=cooking%20MCs;userdata=<user data>;comment2=%20like%20a%20pound%20of%20bacon
+func (s *UserDataService) Cookie(userData string) (iv, ciphertext []byte) {
+	iv = make([]byte, s.block.BlockSize())
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		panic(err)
+	}
+	return iv, CBCEncrypt(s.block, iv, []byte(userDataPrefix+QuoteUserData(userData)+userDataSuffix))
+}
+
+// IsAdmin decrypts the cookie and indicates if it has an admin=true field.
+func (s *UserDataService) IsAdmin(iv, ciphertext []byte) (bool, error) {
+	plaintext, err := CBCDecrypt(s.block, iv, ciphertext)
+	if err != nil {
+		return false, err
+	}
+	for _, field := range strings.Split(string(plaintext), ";") {
+		if field == "admin=true" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,63 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"testing"
+)
+
+func TestCBCBitFlip(t *testing.T) {
+	service, err := NewUserDataService(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// injecting the fields directly doesn't work
+	if admin, err := service.IsAdmin(service.Cookie(";admin=true")); err != nil || admin {
+		t.Fatalf("expected the user data to be quoted (%v)\n", err)
+	}
+
+	// a sacrificial block followed by a block holding the known plaintext
+	known := []byte("XXXXX:admin<true")
+	desired := []byte("XXXXX;admin=true")
+	iv, ciphertext := service.Cookie(string(bytes.Repeat([]byte{'X'}, aes.BlockSize)) + string(known))
+	offset := len(userDataPrefix) + aes.BlockSize
+
+	forged, err := CBCBitFlip(ciphertext, aes.BlockSize, offset, known, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if admin, err := service.IsAdmin(iv, forged); err != nil || !admin {
+		t.Fatalf("expected to forge an admin cookie (%v)\n", err)
+	}
+
+	// only the sacrificial block and the edited one changed
+	p := make([]byte, len(forged))
+	NewCBCDecrypter(service.block, iv).CryptBlocks(p, forged)
+	if !bytes.Equal(p[offset:offset+len(desired)], desired) || !bytes.Equal(p[:offset-aes.BlockSize], []byte(userDataPrefix)) {
+		t.Fatalf("unexpected forged plaintext %q\n", p)
+	}
+}
+
+func TestCBCBitFlipErrors(t *testing.T) {
+	testCases := []struct {
+		offset  int
+		known   string
+		desired string
+		err     error
+	}{
+		{16, "abc", "ab", ErrBitFlipLength},
+		{8, "abc", "abd", ErrBitFlipRange},
+		{30, "abc", "abd", ErrBitFlipRange},
+		{62, "abc", "abd", ErrBitFlipRange},
+		{16, "abc", "abd", nil},
+		{61, "abc", "abd", nil},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if _, err := CBCBitFlip(commonInput, aes.BlockSize, tc.offset, []byte(tc.known), []byte(tc.desired)); err != tc.err {
+			t.Fatalf("expected %v but got %v\n", tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package kripto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// keyAsIVAttempts is the number of forged ciphertexts tried until one makes the oracle leak its plaintext
+const keyAsIVAttempts = 64
+
+var (
+	// ErrKeyAsIVCiphertext is returned when the ciphertext is too short to recover the key.
+	ErrKeyAsIVCiphertext = errors.New("kripto: the ciphertext must have at least 2 full blocks")
+	// ErrNoLeak is returned when the oracle doesn't leak the plaintext of the forged ciphertexts.
+	ErrNoLeak = errors.New("kripto: the oracle didn't leak the decrypted plaintext")
+)
+
+// InvalidASCIIError is returned when a decrypted plaintext has high-ASCII bytes,
+// it carries the offending plaintext (like a verbose error message would).
+type InvalidASCIIError struct {
+	Plaintext []byte
+}
+
+func (e *InvalidASCIIError) Error() string {
+	return fmt.Sprintf("kripto: invalid ASCII plaintext %q", e.Plaintext)
+}
+
+// RecoverKeyAsIV recovers the key of a system encrypting in CBC mode using the key as IV
+// (https://cryptopals.com/sets/4/challenges/27).
+// The oracle decrypts a ciphertext and returns an *InvalidASCIIError when the plaintext has high-ASCII bytes.
+//
+// The ciphertext C1 || C2 ... is turned into C1 || R || C1 || C2 ...: the first plaintext block is P1 = D(C1) ^ key
+// and the third one is P3 = D(C1) ^ R, with R made of zeros P1 ^ P3 is the key.
+// R decrypts to garbage which most likely triggers the error and the original blocks keep the padding valid.
+// R is randomized when the garbage happens to be valid ASCII, the key is then P1 ^ P3 ^ R.
+func RecoverKeyAsIV(ciphertext []byte, blockSize int, oracle func(ciphertext []byte) error) ([]byte, error) {
+	if len(ciphertext) < 2*blockSize || len(ciphertext)%blockSize != 0 {
+		return nil, ErrKeyAsIVCiphertext
+	}
+	c1 := ciphertext[:blockSize]
+	r := make([]byte, blockSize)
+	for attempt := 0; attempt < keyAsIVAttempts; attempt++ {
+		if attempt > 0 {
+			if _, err := io.ReadFull(rand.Reader, r); err != nil {
+				return nil, err
+			}
+		}
+		forged := make([]byte, 0, len(ciphertext)+2*blockSize)
+		forged = append(append(append(forged, c1...), r...), ciphertext...)
+
+		leak, ok := oracle(forged).(*InvalidASCIIError)
+		if !ok || len(leak.Plaintext) < 3*blockSize {
+			continue
+		}
+		p1 := leak.Plaintext[:blockSize]
+		p3 := leak.Plaintext[2*blockSize : 3*blockSize]
+		return MultiCharXor(MultiCharXor(p1, p3), r), nil
+	}
+	return nil, ErrNoLeak
+}
+
+// KeyAsIVService is a stand-in for a service encrypting messages using AES in CBC mode with the key as IV
+// and rejecting the decrypted messages with high-ASCII bytes, reporting the offending plaintext.
+// It is built on the CBC mode of this package.
+type KeyAsIVService struct {
+	block cipher.Block
+	key   []byte
+}
+
+// NewKeyAsIVService returns a service using the AES key as key and IV.
+func NewKeyAsIVService(key []byte) (*KeyAsIVService, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyAsIVService{block: block, key: append([]byte{}, key...)}, nil
+}
+
+// Encrypt encrypts the message using the key as IV.
+func (s *KeyAsIVService) Encrypt(message []byte) []byte {
+	return CBCEncrypt(s.block, s.key, message)
+}
+
+// Check decrypts the ciphertext and returns an *InvalidASCIIError if the plaintext has high-ASCII bytes
+// (or the padding error if the padding is invalid).
+func (s *KeyAsIVService) Check(ciphertext []byte) error {
+	plaintext, err := CBCDecrypt(s.block, s.key, ciphertext)
+	if err != nil {
+		return err
+	}
+	for _, b := range plaintext {
+		if b >= 0x80 {
+			return &InvalidASCIIError{Plaintext: plaintext}
+		}
+	}
+	return nil
+}
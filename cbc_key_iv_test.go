@@ -0,0 +1,30 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"testing"
+)
+
+func TestRecoverKeyAsIV(t *testing.T) {
+	service, err := NewKeyAsIVService(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext := service.Encrypt([]byte("comment1=cooking%20MCs;userdata=foo;comment2=%20like%20a%20pound%20of%20bacon"))
+	if err := service.Check(ciphertext); err != nil {
+		t.Fatalf("expected the original message to be valid but got %v\n", err)
+	}
+
+	key, err := RecoverKeyAsIV(ciphertext, aes.BlockSize, service.Check)
+	if err != nil || !bytes.Equal(key, commonKey128) {
+		t.Fatalf("expected to recover\n%x\nbut got\n%x (%v)\n", commonKey128, key, err)
+	}
+
+	if _, err := RecoverKeyAsIV(ciphertext[:aes.BlockSize], aes.BlockSize, service.Check); err != ErrKeyAsIVCiphertext {
+		t.Fatalf("expected %v but got %v\n", ErrKeyAsIVCiphertext, err)
+	}
+	if _, err := RecoverKeyAsIV(ciphertext, aes.BlockSize, func([]byte) error { return nil }); err != ErrNoLeak {
+		t.Fatalf("expected %v but got %v\n", ErrNoLeak, err)
+	}
+}
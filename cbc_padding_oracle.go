@@ -0,0 +1,182 @@
+package kripto
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrPaddingOracle is returned when no byte value gives a valid padding,
+// the oracle is most likely not a padding oracle.
+var ErrPaddingOracle = errors.New("kripto: no byte value gives a valid padding")
+
+// PaddingOracle reports whether the ciphertext decrypts (in CBC mode with the given IV)
+// to a plaintext with a valid PKCS#7 padding.
+type PaddingOracle func(iv, ciphertext []byte) bool
+
+// PaddingOracleAttack decrypts and encrypts CBC data using a padding oracle
+// (https://cryptopals.com/sets/3/challenges/17).
+//
+// Each block goes through the block cipher on its own: sending a forged IV along with the block reveals,
+// byte after byte, the intermediate state of its decryption (see CBCBlockState). XORing it with the
+// previous ciphertext block gives the plaintext, XORing it with a chosen plaintext block gives the previous
+// block that decrypts to it (CBC-R).
+type PaddingOracleAttack struct {
+	Oracle    PaddingOracle
+	BlockSize int
+	// Concurrency is the number of blocks decrypted in parallel (1 if not positive).
+	// The oracle must be safe for concurrent use when it's more than 1.
+	Concurrency int
+	// Progress is called with the number of bytes done and to do after each recovered byte
+	// (the calls are serialized).
+	Progress func(done, total int)
+
+	mu   sync.Mutex
+	done int
+}
+
+// NewPaddingOracleAttack returns an attack using the oracle of a block cipher with the given block size.
+func NewPaddingOracleAttack(oracle PaddingOracle, blockSize int) *PaddingOracleAttack {
+	return &PaddingOracleAttack{Oracle: oracle, BlockSize: blockSize}
+}
+
+// Decrypt decrypts the ciphertext block by block, the returned plaintext still has its padding (see PKCS7Unpad).
+func (a *PaddingOracleAttack) Decrypt(iv, ciphertext []byte) ([]byte, error) {
+	bs := a.BlockSize
+	if len(iv) != bs || len(ciphertext) == 0 || len(ciphertext)%bs != 0 {
+		return nil, ErrPaddingLength
+	}
+	blocks := len(ciphertext) / bs
+	a.startProgress()
+	concurrency := a.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	errs := make([]error, blocks)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < blocks; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			prev := iv
+			if i > 0 {
+				prev = ciphertext[(i-1)*bs : i*bs]
+			}
+			intermediate, err := a.intermediate(ciphertext[i*bs:(i+1)*bs], len(ciphertext))
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			copy(plaintext[i*bs:], MultiCharXor(intermediate, prev))
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plaintext, nil
+}
+
+// Encrypt encrypts the plaintext (after padding it using PKCS#7) without knowing the key, using the CBC-R technique:
+// starting from a random last block, each previous block is the intermediate state of the next one
+// XORed with its plaintext, the first one being the IV.
+func (a *PaddingOracleAttack) Encrypt(plaintext []byte) (iv, ciphertext []byte, err error) {
+	bs := a.BlockSize
+	padded, err := PKCS7Pad(plaintext, bs)
+	if err != nil {
+		return nil, nil, err
+	}
+	blocks := len(padded) / bs
+	a.startProgress()
+
+	out := make([]byte, len(padded)+bs)
+	if _, err := io.ReadFull(rand.Reader, out[len(padded):]); err != nil {
+		return nil, nil, err
+	}
+	for i := blocks - 1; i >= 0; i-- {
+		intermediate, err := a.intermediate(out[(i+1)*bs:(i+2)*bs], len(padded))
+		if err != nil {
+			return nil, nil, err
+		}
+		copy(out[i*bs:], MultiCharXor(intermediate, padded[i*bs:(i+1)*bs]))
+	}
+	return out[:bs], out[bs:], nil
+}
+
+// Intermediate returns the intermediate state of the block decryption, that is its decryption before the XOR
+// with the previous block.
+func (a *PaddingOracleAttack) Intermediate(block []byte) ([]byte, error) {
+	if len(block) != a.BlockSize {
+		return nil, ErrPaddingLength
+	}
+	a.startProgress()
+	return a.intermediate(block, len(block))
+}
+
+// intermediate finds the intermediate state of the block from the last byte to the first one:
+// once the bytes after pos are known, the forged IV makes them decrypt to the padding value
+// and each value of the byte at pos is tried until the padding is valid.
+func (a *PaddingOracleAttack) intermediate(block []byte, total int) ([]byte, error) {
+	bs := a.BlockSize
+	intermediate := make([]byte, bs)
+	forged := make([]byte, bs)
+	for pos := bs - 1; pos >= 0; pos-- {
+		pad := byte(bs - pos)
+		for k := pos + 1; k < bs; k++ {
+			forged[k] = intermediate[k] ^ pad
+		}
+		found := false
+		for g := 0; g < 256; g++ {
+			forged[pos] = byte(g)
+			if !a.Oracle(forged, block) {
+				continue
+			}
+			if pos == bs-1 && bs > 1 && !a.lastByteConfirmed(forged, block) {
+				continue
+			}
+			intermediate[pos] = byte(g) ^ pad
+			found = true
+			break
+		}
+		if !found {
+			return nil, ErrPaddingOracle
+		}
+		a.progress(total)
+	}
+	return intermediate, nil
+}
+
+// lastByteConfirmed checks a valid padding found for the last byte is \x01: the plaintext could end
+// with \x02\x02 (or \x03\x03\x03...) by chance, changing the byte before the last one breaks such paddings.
+func (a *PaddingOracleAttack) lastByteConfirmed(forged, block []byte) bool {
+	check := append([]byte{}, forged...)
+	check[len(check)-2] ^= 0xff
+	return a.Oracle(check, block)
+}
+
+func (a *PaddingOracleAttack) startProgress() {
+	a.mu.Lock()
+	a.done = 0
+	a.mu.Unlock()
+}
+
+func (a *PaddingOracleAttack) progress(total int) {
+	if a.Progress == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.done++
+	a.Progress(a.done, total)
+}
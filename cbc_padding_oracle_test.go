@@ -0,0 +1,115 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"math/rand"
+	"testing"
+)
+
+// paddingServer is an in-process stand-in for a server decrypting AES-CBC tokens
+// and telling whether their padding is valid.
+type paddingServer struct {
+	block cipher.Block
+	rnd   *rand.Rand
+}
+
+func newPaddingServer(t *testing.T, seed int64) *paddingServer {
+	rnd := rand.New(rand.NewSource(seed))
+	block, err := aes.NewCipher(randomBytes(rnd, aes.BlockSize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &paddingServer{block: block, rnd: rnd}
+}
+
+func (s *paddingServer) token(plaintext []byte) (iv, ciphertext []byte) {
+	iv = randomBytes(s.rnd, aes.BlockSize)
+	return iv, CBCEncrypt(s.block, iv, plaintext)
+}
+
+func (s *paddingServer) oracle(iv, ciphertext []byte) bool {
+	_, err := CBCDecrypt(s.block, iv, ciphertext)
+	return err == nil
+}
+
+func TestPaddingOracleAttackDecrypt(t *testing.T) {
+	server := newPaddingServer(t, 17)
+	testCases := []string{
+		"",
+		"YELLOW SUBMARINE",
+		"000000Now that the party is jumping",
+		"000003Cooking MC's like a pound of bacon\x02",
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		iv, ciphertext := server.token([]byte(tc))
+		for _, concurrency := range []int{1, 3} {
+			attack := NewPaddingOracleAttack(server.oracle, aes.BlockSize)
+			attack.Concurrency = concurrency
+			calls, last := 0, 0
+			attack.Progress = func(done, total int) {
+				calls++
+				if done != calls || total != len(ciphertext) {
+					t.Errorf("unexpected progress %d/%d after %d calls\n", done, total, calls)
+				}
+				last = done
+			}
+			o, err := attack.Decrypt(iv, ciphertext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o, err = PKCS7Unpad(o, aes.BlockSize); err != nil || string(o) != tc {
+				t.Fatalf("expected %q\ngot\n%q (%v)\n", tc, o, err)
+			}
+			if last != len(ciphertext) {
+				t.Fatalf("expected the progress to reach %d but got %d\n", len(ciphertext), last)
+			}
+		}
+	}
+}
+
+func TestPaddingOracleAttackLastByteFalsePositive(t *testing.T) {
+	server := newPaddingServer(t, 23)
+	attack := NewPaddingOracleAttack(server.oracle, aes.BlockSize)
+
+	// a block whose intermediate state decrypts to \x02 before the last byte when the forged IV is
+	// made of zeros, so the \x02\x02 padding is valid too
+	intermediate := make([]byte, aes.BlockSize)
+	var block []byte
+	for {
+		block = randomBytes(server.rnd, aes.BlockSize)
+		server.block.Decrypt(intermediate, block)
+		if intermediate[aes.BlockSize-2] == 2 && intermediate[aes.BlockSize-1]^2 < intermediate[aes.BlockSize-1]^1 {
+			break
+		}
+	}
+
+	o, err := attack.Intermediate(block)
+	if err != nil || !bytes.Equal(o, intermediate) {
+		t.Fatalf("expected\n%x\ngot\n%x (%v)\n", intermediate, o, err)
+	}
+}
+
+func TestPaddingOracleAttackEncrypt(t *testing.T) {
+	server := newPaddingServer(t, 29)
+	attack := NewPaddingOracleAttack(server.oracle, aes.BlockSize)
+	plaintext := []byte("comment1=cooking%20MCs;userdata=x;admin=true")
+
+	iv, ciphertext, err := attack.Encrypt(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o, err := CBCDecrypt(server.block, iv, ciphertext); err != nil || !bytes.Equal(o, plaintext) {
+		t.Fatalf("expected %q\ngot\n%q (%v)\n", plaintext, o, err)
+	}
+}
+
+func TestPaddingOracleAttackNotAnOracle(t *testing.T) {
+	attack := NewPaddingOracleAttack(func(iv, ciphertext []byte) bool { return false }, aes.BlockSize)
+	if _, err := attack.Decrypt(commonIV, commonInput); err != ErrPaddingOracle {
+		t.Fatalf("expected %v but got %v\n", ErrPaddingOracle, err)
+	}
+}
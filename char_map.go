@@ -42,8 +42,13 @@ var EnglishLetterFreqs = &CharUseMap{
 
 // CharStats represents the metrics of a character from a char map
 type CharStats struct {
-	Freq  float64
+	// Freq is the relative frequency of the character (Count divided by the number of characters)
+	Freq float64
+	// Count is the number of occurrences of the character
 	Count float64
+	// UpperCount is the number of occurrences of the character that were uppercase
+	// (see NewCharMap for the letters it lowercases)
+	UpperCount float64
 }
 
 // CharUseMap map of usage per character (byte)
@@ -224,22 +229,74 @@ func (maps *CharMaps) MostEnglish() *CharUseMap {
 	return m[winnerIDX]
 }
 
-// NewCharMap returns a map of the character usage (converted to lowercase)
+// NewCharMap returns a map of the character usage (converted to lowercase).
+// Besides the ASCII letters, the UTF-8 encoded Latin-1 letters (such as É, C3 89) are lowercased,
+// the other non ASCII letters (such as Œ) are counted as is.
 func NewCharMap(str []byte) *CharUseMap {
-	charCount := map[byte]int{}
-	for _, b := range str {
+	m := CharUseMap{}
+	for i, b := range str {
+		upper := b >= 'A' && b <= 'Z'
 		// lowercase ASCII letters
 		if IsASCIILetter(b) {
 			b = bytes.ToLower([]byte{b})[0]
 		}
-		charCount[b]++
-	}
-	m := CharUseMap{}
-	for b, count := range charCount {
-		m[b] = &CharStats{
-			Count: float64(count),
-			Freq:  float64(count) / float64(len(charCount)),
+		// lowercase Latin-1 letters, from À (C3 80) to Þ (C3 9E) except × (C3 97)
+		if i > 0 && str[i-1] == 0xC3 && b >= 0x80 && b <= 0x9E && b != 0x97 {
+			b += 0x20
+			upper = true
+		}
+		stats, ok := m[b]
+		if !ok {
+			stats = &CharStats{}
+			m[b] = stats
+		}
+		stats.Count++
+		if upper {
+			stats.UpperCount++
 		}
 	}
+	m.updateFreqs()
 	return &m
 }
+
+// Total returns the number of characters used to build the map
+func (m CharUseMap) Total() float64 {
+	var total float64
+	for _, stats := range m {
+		total += stats.Count
+	}
+	return total
+}
+
+// Entropy returns the Shannon entropy of the character distribution in bits per character,
+// from 0 for a single repeated character. ASCII letters are counted case insensitively so
+// uniformly distributed bytes give log2(230) (about 7.85 bits), use ShannonEntropy to measure
+// the raw bytes.
+func (m CharUseMap) Entropy() float64 {
+	total := m.Total()
+	if total == 0 {
+		return 0
+	}
+	var entropy float64
+	// iterate in byte order so equivalent maps get the exact same result
+	for i := 0; i < 256; i++ {
+		stats, ok := m[byte(i)]
+		if !ok || stats.Count == 0 {
+			continue
+		}
+		p := stats.Count / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// updateFreqs sets the frequency of each character based on the counts.
+func (m CharUseMap) updateFreqs() {
+	total := m.Total()
+	if total == 0 {
+		return
+	}
+	for _, stats := range m {
+		stats.Freq = stats.Count / total
+	}
+}
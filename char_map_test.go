@@ -1,6 +1,10 @@
 package kripto
 
-import "testing"
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+)
 
 func TestLooksEnglish(t *testing.T) {
 	Debug = false
@@ -30,3 +34,85 @@ func TestLooksEnglish(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCharMap(t *testing.T) {
+	testCases := []struct {
+		input   string
+		char    byte
+		count   float64
+		upper   float64
+		freq    float64
+		entropy float64
+	}{
+		{"aAaA", 'a', 4, 2, 1, 0},
+		{"abcd", 'b', 1, 0, 0.25, 2},
+		{"Hello World", 'l', 3, 0, 3.0 / 11, 2.8453509366224368},
+		{"Hello World", 'h', 1, 1, 1.0 / 11, 2.8453509366224368},
+		// É (C3 89) and é (C3 A9)
+		{"Été", 0xA9, 2, 1, 2.0 / 5, 1.5219280948873621},
+		// × (C3 97) isn't a letter
+		{"×", 0x97, 1, 0, 0.5, 1},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		m := NewCharMap([]byte(tc.input))
+		if total := m.Total(); total != float64(len(tc.input)) {
+			t.Fatalf("expected a total of %d, got %f", len(tc.input), total)
+		}
+		stats := (*m)[tc.char]
+		if stats == nil {
+			t.Fatalf("%q missing from the map", tc.char)
+		}
+		if stats.Count != tc.count || stats.UpperCount != tc.upper {
+			t.Fatalf("expected %q to be seen %f times (%f uppercase), got %+v", tc.char, tc.count, tc.upper, *stats)
+		}
+		if math.Abs(stats.Freq-tc.freq) > 1e-9 {
+			t.Fatalf("expected %q to have a frequency of %f, got %f", tc.char, tc.freq, stats.Freq)
+		}
+		var freqs float64
+		for _, s := range *m {
+			freqs += s.Freq
+		}
+		if math.Abs(freqs-1) > 1e-9 {
+			t.Fatalf("expected the frequencies to add up to 1, got %f", freqs)
+		}
+		if e := m.Entropy(); math.Abs(e-tc.entropy) > 1e-9 {
+			t.Fatalf("expected an entropy of %f, got %f", tc.entropy, e)
+		}
+	}
+}
+
+func TestScorersBreakFixtures(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		fn     func([]byte) []byte
+		key    byte
+	}{
+		{"1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736", "Cooking MC's like a pound of bacon", DeHex, 'X'},
+		{"7b5a4215415d544115415d5015455447414c155c46155f4058455c5b523f", "Now that the party is jumping\n", DeHex, '5'},
+		{"aVhTWl5FCkNZCkxfRAYKQ1lEDV4KQ14V", "Crypto is fun, isn't it?", DeBase64, 42},
+	}
+	sixTxt, err := ioutil.ReadFile(fixturePath("6.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scorers := map[string]CharMapScorer{
+		"english": &EnglishScorer{WithSpace: true},
+		"ascii":   &ASCIIScorer{},
+	}
+	for name, scorer := range scorers {
+		for i, tc := range testCases {
+			t.Logf("%s test case %d\n", name, i)
+			if o, k := BreakSingleCharXor([]byte(tc.input), tc.fn, scorer); string(o) != tc.output || k != tc.key {
+				t.Fatalf("expected to get:\n'%s' using key %s\nbut got\n'%s'\n from key %s\n", tc.output, string(tc.key), o, string(k))
+			}
+		}
+		t.Logf("%s 6.txt\n", name)
+		if _, k := BreakMultiCharXorWithScorer(sixTxt, DeBase64, scorer, 40); string(k) != "Terminator X: Bring the noise" {
+			t.Fatalf("key not properly found, got '%s'", string(k))
+		}
+	}
+}
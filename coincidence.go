@@ -0,0 +1,109 @@
+package kripto
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RandomIoC is the index of coincidence expected from uniformly random bytes.
+const RandomIoC = 1.0 / 256
+
+// IndexOfCoincidence returns the probability that two bytes picked at random
+// from the data are identical.
+// Text encrypted with a single character xor key keeps the index of its plaintext
+// (~0.065 for English letters, higher once spaces are included)
+// while random data tends towards RandomIoC.
+func IndexOfCoincidence(data []byte) float64 {
+	if len(data) < 2 {
+		return 0
+	}
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	var sum int
+	for _, c := range counts {
+		sum += c * (c - 1)
+	}
+	n := len(data)
+	return float64(sum) / float64(n*(n-1))
+}
+
+// IoCPeriod reports the index of coincidence statistics of data transposed for a given period.
+type IoCPeriod struct {
+	// Size is the analyzed period (key size).
+	Size int
+	// ColumnIoC is the index of coincidence of each transposed column.
+	ColumnIoC []float64
+	// AvgIoC is the average of the column indexes.
+	AvgIoC float64
+}
+
+func (p *IoCPeriod) String() string {
+	return fmt.Sprintf("%d -> %0.4f %v", p.Size, p.AvgIoC, p.ColumnIoC)
+}
+
+// IoCPeriods is a collection of periods ranked by their average index of coincidence.
+type IoCPeriods []*IoCPeriod
+
+// Len implements the sort interface
+func (p IoCPeriods) Len() int {
+	return len(p)
+}
+
+// Swap implements the sort interface
+func (p IoCPeriods) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
+
+// Less implements the sort interface, highest indexes first and smaller sizes first on ties.
+func (p IoCPeriods) Less(i, j int) bool {
+	if p[i].AvgIoC == p[j].AvgIoC {
+		return p[i].Size < p[j].Size
+	}
+	return p[i].AvgIoC > p[j].AvgIoC
+}
+
+// Sizes returns the periods in the collection order.
+func (p IoCPeriods) Sizes() []int {
+	sizes := make([]int, len(p))
+	for i, period := range p {
+		sizes[i] = period.Size
+	}
+	return sizes
+}
+
+func (p IoCPeriods) String() string {
+	var o string
+	for _, period := range p {
+		o += period.String() + "\n"
+	}
+	return o
+}
+
+// IoCKeySizes estimates the size of a repeating key (see MultiCharXor) using the index of coincidence.
+// For each period between 2 and maxKeySize, the data is transposed the same way BreakMultiCharXor does
+// and the index of coincidence of each column is computed.
+// When the period matches the key size, each column is encrypted with a single byte
+// and its index stays close to the plaintext's one.
+// The periods are returned ranked by their average column index.
+func IoCKeySizes(data []byte, maxKeySize int) IoCPeriods {
+	periods := IoCPeriods{}
+	for size := 2; size <= maxKeySize; size++ {
+		// we need at least 2 bytes per column
+		if size*2 > len(data) {
+			break
+		}
+		period := &IoCPeriod{Size: size}
+		var sum float64
+		for _, col := range transposeBlocks(data, size) {
+			ioc := IndexOfCoincidence(col)
+			period.ColumnIoC = append(period.ColumnIoC, ioc)
+			sum += ioc
+		}
+		period.AvgIoC = sum / float64(size)
+		periods = append(periods, period)
+	}
+	sort.Sort(periods)
+	return periods
+}
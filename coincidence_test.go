@@ -0,0 +1,60 @@
+package kripto
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+)
+
+func TestIndexOfCoincidence(t *testing.T) {
+	testCases := []struct {
+		input string
+		ioc   float64
+	}{
+		{"", 0},
+		{"a", 0},
+		{"aaaa", 1},
+		{"abcd", 0},
+		{"aabb", 1.0 / 3},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if o := IndexOfCoincidence([]byte(tc.input)); math.Abs(o-tc.ioc) > 1e-9 {
+			t.Fatalf("expected %f\ngot\n%f\n", tc.ioc, o)
+		}
+	}
+}
+
+func TestIoCKeySizes(t *testing.T) {
+	testCases := []struct {
+		file string
+		size int
+	}{
+		{"6.txt", 29},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		data, err := ioutil.ReadFile(fixturePath(tc.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		periods := IoCKeySizes(DeBase64(data), 40)
+		if len(periods) != 39 {
+			t.Fatalf("expected 39 periods, got %d", len(periods))
+		}
+		best := periods[0]
+		if best.Size != tc.size {
+			t.Fatalf("expected %d\ngot\n%v\n", tc.size, periods.Sizes())
+		}
+		if len(best.ColumnIoC) != tc.size {
+			t.Fatalf("expected %d columns, got %d", tc.size, len(best.ColumnIoC))
+		}
+		for j, ioc := range best.ColumnIoC {
+			if ioc < RandomIoC*4 {
+				t.Fatalf("column %d doesn't look like it was encoded with a single byte: %f", j, ioc)
+			}
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package kripto
+
+import "math"
+
+// ChiSquaredScorer scores a character map using Pearson's chi-squared statistic
+// of its character counts against a reference distribution.
+// The printable characters missing from the reference are grouped in a single "other" category
+// and the non printable ones in a category that isn't expected.
+// The score is the negated statistic, so the higher, the closer to the reference.
+type ChiSquaredScorer struct {
+	// Reference is the expected distribution, EnglishLetterFreqs if nil.
+	Reference *CharUseMap
+	// WithSpace indicates if the analyzed text is expected to have white spaces or not,
+	// if so spaces are expected to represent 15% of the characters.
+	WithSpace bool
+	// OtherFreq is the expected frequency of the other characters missing from the reference
+	// (punctuation when using EnglishLetterFreqs).
+	OtherFreq float64
+}
+
+// Score implements the CharMapScorer interface
+func (s *ChiSquaredScorer) Score(m *CharUseMap) float64 {
+	return -ChiSquared(m, referenceWithSpace(s.Reference, s.WithSpace), s.OtherFreq)
+}
+
+// BhattacharyyaScorer scores a character map using the Bhattacharyya distance
+// between its character distribution and a reference distribution.
+// The printable characters missing from the reference are grouped in a single "other" category
+// and the non printable ones in a category that isn't expected.
+// The score is the negated distance, so the higher, the closer to the reference.
+type BhattacharyyaScorer struct {
+	// Reference is the expected distribution, EnglishLetterFreqs if nil.
+	Reference *CharUseMap
+	// WithSpace indicates if the analyzed text is expected to have white spaces or not,
+	// if so spaces are expected to represent 15% of the characters.
+	WithSpace bool
+	// OtherFreq is the expected frequency of the other characters missing from the reference
+	// (punctuation when using EnglishLetterFreqs).
+	OtherFreq float64
+}
+
+// Score implements the CharMapScorer interface
+func (s *BhattacharyyaScorer) Score(m *CharUseMap) float64 {
+	return -BhattacharyyaDistance(m, referenceWithSpace(s.Reference, s.WithSpace), s.OtherFreq)
+}
+
+// ChiSquared returns Pearson's chi-squared statistic of the character counts of m
+// against the ref distribution (EnglishLetterFreqs if nil).
+// otherFreq is the expected frequency of the printable characters missing from ref, they are counted together.
+// 0 means a perfect fit, the bigger the statistic, the less likely m follows the reference distribution.
+func ChiSquared(m, ref *CharUseMap, otherFreq float64) float64 {
+	observed, expected := distributions(m, ref, otherFreq)
+	total := observed[256]
+	if total == 0 {
+		return math.Inf(1)
+	}
+
+	var chi2 float64
+	for i := 0; i < 256; i++ {
+		e := expected[i] * total
+		if e == 0 {
+			if observed[i] == 0 {
+				continue
+			}
+			// a character that should never be seen, using the smallest possible expectation
+			e = 1 / total
+		}
+		d := observed[i] - e
+		chi2 += d * d / e
+	}
+	return chi2
+}
+
+// BhattacharyyaDistance returns the Bhattacharyya distance between the character distribution of m
+// and the ref distribution (EnglishLetterFreqs if nil).
+// otherFreq is the expected frequency of the printable characters missing from ref, they are counted together.
+// 0 means identical distributions, the distance is infinite when they don't overlap.
+func BhattacharyyaDistance(m, ref *CharUseMap, otherFreq float64) float64 {
+	observed, expected := distributions(m, ref, otherFreq)
+	total := observed[256]
+	if total == 0 {
+		return math.Inf(1)
+	}
+
+	var coef float64
+	for i := 0; i < 256; i++ {
+		coef += math.Sqrt(observed[i] / total * expected[i])
+	}
+	if coef == 0 {
+		return math.Inf(1)
+	}
+	return -math.Log(coef)
+}
+
+// minLikelihood is the probability given to characters the reference doesn't expect
+const minLikelihood = 1e-6
+
+// LogLikelihood returns the average natural log probability of the characters of m
+// given the ref distribution (EnglishLetterFreqs if nil), so 0 is the best possible value.
+// otherFreq is the expected frequency of the printable characters missing from ref, they are counted together.
+// Unlike ChiSquared, a single unexpected character can't outweigh the rest of the characters.
+func LogLikelihood(m, ref *CharUseMap, otherFreq float64) float64 {
+	observed, expected := distributions(m, ref, otherFreq)
+	total := observed[256]
+	if total == 0 {
+		return math.Inf(-1)
+	}
+
+	var sum float64
+	for i := 0; i < 256; i++ {
+		if observed[i] == 0 {
+			continue
+		}
+		sum += observed[i] * math.Log(math.Max(expected[i], minLikelihood))
+	}
+	return sum / total
+}
+
+// spaceFreq is the expected frequency of spaces in spaced text
+const spaceFreq = 0.15
+
+// referenceWithSpace returns the ref distribution (EnglishLetterFreqs if nil)
+// with spaces added when the text is expected to be spaced.
+func referenceWithSpace(ref *CharUseMap, withSpace bool) *CharUseMap {
+	if ref == nil {
+		ref = EnglishLetterFreqs
+	}
+	if !withSpace {
+		return ref
+	}
+	var total float64
+	for _, stats := range *ref {
+		total += stats.Freq
+	}
+	spaced := CharUseMap{}
+	for b, stats := range *ref {
+		spaced[b] = &CharStats{Freq: stats.Freq / total * (1 - spaceFreq)}
+	}
+	spaced[' '] = &CharStats{Freq: spaceFreq}
+	return &spaced
+}
+
+// distributions returns the observed counts of m and the expected frequencies,
+// both indexed by character. The printable characters missing from the reference are counted under
+// the index of the first of them, the same goes for the non printable ones.
+// The total count is stored at index 256.
+func distributions(m, ref *CharUseMap, otherFreq float64) (observed, expected [257]float64) {
+	if ref == nil {
+		ref = EnglishLetterFreqs
+	}
+	otherFreq = math.Min(math.Max(otherFreq, 0), 1)
+
+	var refTotal float64
+	other, invalid := -1, -1
+	// iterate in byte order so equivalent maps get the exact same result
+	for i := 0; i < 256; i++ {
+		if stats, ok := (*ref)[byte(i)]; ok {
+			refTotal += stats.Freq
+			continue
+		}
+		if IsPrintable(byte(i)) {
+			if other == -1 {
+				other = i
+			}
+		} else if invalid == -1 {
+			invalid = i
+		}
+	}
+	if other == -1 {
+		otherFreq = 0
+	} else {
+		expected[other] = otherFreq
+	}
+	for i := 0; i < 256; i++ {
+		if stats, ok := (*ref)[byte(i)]; ok && refTotal > 0 {
+			expected[i] = stats.Freq / refTotal * (1 - otherFreq)
+		}
+	}
+
+	for i := 0; i < 256; i++ {
+		stats, ok := (*m)[byte(i)]
+		if !ok {
+			continue
+		}
+		idx := i
+		if _, ok := (*ref)[byte(i)]; !ok {
+			if IsPrintable(byte(i)) && other != -1 {
+				idx = other
+			} else if invalid != -1 {
+				idx = invalid
+			}
+		}
+		observed[idx] += stats.Count
+		observed[256] += stats.Count
+	}
+	return observed, expected
+}
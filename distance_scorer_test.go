@@ -0,0 +1,117 @@
+package kripto
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+)
+
+func TestDistanceScorersBreakSingleCharXor(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		fn     func([]byte) []byte
+		key    byte
+	}{
+		{
+			"1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736",
+			"Cooking MC's like a pound of bacon",
+			DeHex,
+			'X',
+		},
+		{
+			"7b5a4215415d544115415d5015455447414c155c46155f4058455c5b523f",
+			"Now that the party is jumping\n",
+			DeHex,
+			'5',
+		},
+		{
+			"aVhTWl5FCkNZCkxfRAYKQ1lEDV4KQ14V",
+			"Crypto is fun, isn't it?",
+			DeBase64,
+			42,
+		},
+		{
+			string([]byte{0xe, 0x3f, 0x34, 0x3d, 0x39, 0x22, 0x6d, 0x24, 0x3e, 0x6d, 0x2b, 0x38, 0x23, 0x61, 0x6d, 0x24, 0x3e, 0x23, 0x6a, 0x39, 0x6d, 0x24, 0x39, 0x72}),
+			"Crypto is fun, isn't it?",
+			nil,
+			'M',
+		},
+	}
+
+	scorers := map[string]CharMapScorer{
+		"chi-squared":   &ChiSquaredScorer{WithSpace: true, OtherFreq: 0.02},
+		"bhattacharyya": &BhattacharyyaScorer{WithSpace: true, OtherFreq: 0.02},
+	}
+	for name, scorer := range scorers {
+		for i, tc := range testCases {
+			t.Logf("%s test case %d\n", name, i)
+			if o, k := BreakSingleCharXor([]byte(tc.input), tc.fn, scorer); string(o) != tc.output || k != tc.key {
+				t.Fatalf("expected to get:\b'%s' using key %s\nbut got\n'%s'\n from key %s\n", tc.output, string(tc.key), o, string(k))
+			}
+		}
+	}
+}
+
+func TestChiSquared(t *testing.T) {
+	ref := &CharUseMap{'a': {Freq: 0.5}, 'b': {Freq: 0.5}}
+	testCases := []struct {
+		input     string
+		otherFreq float64
+		chi2      float64
+		distance  float64
+	}{
+		{"abab", 0, 0, 0},
+		{"aaaa", 0, 4, -math.Log(math.Sqrt(0.5))},
+		{"ab  ", 0.5, 0, 0},
+		{"", 0, math.Inf(1), math.Inf(1)},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		m := NewCharMap([]byte(tc.input))
+		if o := ChiSquared(m, ref, tc.otherFreq); math.Abs(o-tc.chi2) > 1e-9 && !(math.IsInf(o, 1) && math.IsInf(tc.chi2, 1)) {
+			t.Fatalf("expected a chi-squared of %f\ngot\n%f\n", tc.chi2, o)
+		}
+		if o := BhattacharyyaDistance(m, ref, tc.otherFreq); math.Abs(o-tc.distance) > 1e-9 && !(math.IsInf(o, 1) && math.IsInf(tc.distance, 1)) {
+			t.Fatalf("expected a distance of %f\ngot\n%f\n", tc.distance, o)
+		}
+	}
+}
+
+func TestDistanceScorersBreakMultiCharXor(t *testing.T) {
+	data, err := ioutil.ReadFile(fixturePath("6.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scorers := map[string]CharMapScorer{
+		"chi-squared":   &ChiSquaredScorer{WithSpace: true, OtherFreq: 0.02},
+		"bhattacharyya": &BhattacharyyaScorer{WithSpace: true, OtherFreq: 0.02},
+	}
+	for name, scorer := range scorers {
+		t.Logf("%s\n", name)
+		if _, k := BreakMultiCharXorWithScorer(data, DeBase64, scorer, 40); string(k) != "Terminator X: Bring the noise" {
+			t.Fatalf("key not properly found, got '%s'", string(k))
+		}
+	}
+}
+
+func TestLogLikelihood(t *testing.T) {
+	ref := &CharUseMap{'a': {Freq: 0.5}, 'b': {Freq: 0.5}}
+	testCases := []struct {
+		input      string
+		otherFreq  float64
+		likelihood float64
+	}{
+		{"abab", 0, math.Log(0.5)},
+		{"ab  ", 0.5, (math.Log(0.25) + math.Log(0.5)) / 2},
+		{"ab\x00\x00", 0, (math.Log(0.5) + math.Log(minLikelihood)) / 2},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if o := LogLikelihood(NewCharMap([]byte(tc.input)), ref, tc.otherFreq); math.Abs(o-tc.likelihood) > 1e-9 {
+			t.Fatalf("expected %f\ngot\n%f\n", tc.likelihood, o)
+		}
+	}
+}
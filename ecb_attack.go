@@ -0,0 +1,200 @@
+package kripto
+
+import (
+	"bytes"
+	"errors"
+)
+
+var (
+	// ErrNotECB is returned when attacking an oracle that doesn't encrypt in ECB mode.
+	ErrNotECB = errors.New("kripto: the oracle doesn't encrypt in ECB mode")
+	// ErrBlockSize is returned when the block size of an oracle can't be found.
+	ErrBlockSize = errors.New("kripto: can't find the oracle block size")
+	// ErrAlignment is returned when the attacker input can't be aligned on a block boundary.
+	ErrAlignment = errors.New("kripto: can't align the input on a block boundary")
+	// ErrByteNotFound is returned when no byte value matches the encrypted secret.
+	ErrByteNotFound = errors.New("kripto: can't find the next secret byte")
+)
+
+const (
+	// maxBlockSize is the biggest block size DetectBlockSize looks for
+	maxBlockSize = 64
+	// alignAttemptsPerByte is the number of queries per block byte the prefix attack tries to align its input
+	alignAttemptsPerByte = 32
+	// fillerByte is used to move the secret around the block boundaries
+	fillerByte = 'A'
+)
+
+// DetectBlockSize finds the block size of the oracle by growing its input until the ciphertext gets longer.
+func DetectBlockSize(oracle Oracle) (int, error) {
+	initial := len(oracle(nil))
+	for i := 1; i <= maxBlockSize; i++ {
+		if n := len(oracle(bytes.Repeat([]byte{fillerByte}, i))); n > initial {
+			return n - initial, nil
+		}
+	}
+	return 0, ErrBlockSize
+}
+
+// BreakECBByteAtATime recovers the secret the oracle appends to the attacker input before encrypting it
+// in ECB mode, one byte at a time (https://cryptopals.com/sets/2/challenges/12).
+// The block size is discovered by growing the input and the ECB mode is confirmed before the attack.
+// The oracle is expected to pad the data (PKCS#7 for instance) so it always encrypts full blocks.
+func BreakECBByteAtATime(oracle Oracle) ([]byte, error) {
+	blockSize, err := DetectBlockSize(oracle)
+	if err != nil {
+		return nil, err
+	}
+	if DetectBlockMode(oracle, blockSize) != ModeECB {
+		return nil, ErrNotECB
+	}
+	query := func(input []byte) ([]byte, error) {
+		return oracle(input), nil
+	}
+	return breakECBByteAtATime(query, blockSize)
+}
+
+// BreakECBByteAtATimeWithPrefix works like BreakECBByteAtATime when the oracle also adds
+// an unknown prefix of random length before the attacker input (https://cryptopals.com/sets/2/challenges/14).
+// Each query starts with a pad and 2 identical marker blocks: the first pair of identical consecutive ciphertext
+// blocks shows where the attacker input starts. The pad length completing the prefix block is found
+// by trying them all, so the prefix length can even change between queries (at the cost of more queries).
+func BreakECBByteAtATimeWithPrefix(oracle Oracle) ([]byte, error) {
+	// the prefix length can change between queries, the lengths are multiples of the block size
+	blockSize := 0
+	for i := 0; i <= maxBlockSize; i++ {
+		blockSize = gcd(blockSize, len(oracle(bytes.Repeat([]byte{fillerByte}, i))))
+	}
+	if blockSize == 0 || blockSize > maxBlockSize {
+		return nil, ErrBlockSize
+	}
+	if DetectBlockMode(oracle, blockSize) != ModeECB {
+		return nil, ErrNotECB
+	}
+	aligned := &alignedOracle{oracle: oracle, blockSize: blockSize, pad: -1}
+	return breakECBByteAtATime(aligned.query, blockSize)
+}
+
+// alignedOracle removes the encrypted prefix from the ciphertexts of an oracle,
+// so they start with the encrypted attacker input.
+type alignedOracle struct {
+	oracle    Oracle
+	blockSize int
+	// pad is the number of bytes completing the prefix last block, -1 until found.
+	// It is between 1 and the block size so the block before the marker is never a marker block.
+	pad int
+}
+
+func (a *alignedOracle) query(input []byte) ([]byte, error) {
+	markerByte := a.markerByte(input)
+	marker := bytes.Repeat([]byte{markerByte}, 2*a.blockSize)
+	for attempt := 0; attempt < alignAttemptsPerByte*a.blockSize; attempt++ {
+		pad := a.pad
+		if pad < 0 {
+			pad = 1 + attempt%a.blockSize
+		}
+		data := append(bytes.Repeat([]byte{markerByte + 1}, pad), marker...)
+		out := a.oracle(append(data, input...))
+		if start := a.markerEnd(out); start >= 0 {
+			a.pad = pad
+			return out[start:], nil
+		}
+	}
+	return nil, ErrAlignment
+}
+
+// markerByte returns the byte filling the marker blocks, it differs from the first and last bytes
+// of the input first block so the marker blocks can't be mistaken for blocks overlapping the input
+// when the input isn't aligned. The pad is made of the next byte value.
+func (a *alignedOracle) markerByte(input []byte) byte {
+	for b := 0; ; b++ {
+		if len(input) > 0 && input[0] == byte(b) || len(input) >= a.blockSize && input[a.blockSize-1] == byte(b) {
+			continue
+		}
+		return byte(b)
+	}
+}
+
+// markerEnd returns the offset following the first run of identical consecutive blocks, -1 if none.
+// The whole run is skipped in case the prefix ends with blocks identical to the marker ones.
+func (a *alignedOracle) markerEnd(out []byte) int {
+	bs := a.blockSize
+	for i := 0; i+2*bs <= len(out); i += bs {
+		if !bytes.Equal(out[i:i+bs], out[i+bs:i+2*bs]) {
+			continue
+		}
+		end := i + 2*bs
+		for end+bs <= len(out) && bytes.Equal(out[i:i+bs], out[end:end+bs]) {
+			end += bs
+		}
+		return end
+	}
+	return -1
+}
+
+// breakECBByteAtATime recovers the secret following the attacker input in the ciphertexts returned by query.
+func breakECBByteAtATime(query func([]byte) ([]byte, error), blockSize int) ([]byte, error) {
+	secretLen, err := ecbSecretLen(query, blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, 0, secretLen)
+	dictionary := make([]byte, 256*blockSize)
+	for i := 0; i < secretLen; i++ {
+		// the filler puts the byte to recover at the end of a block
+		filler := bytes.Repeat([]byte{fillerByte}, blockSize-1-i%blockSize)
+		out, err := query(filler)
+		if err != nil {
+			return nil, err
+		}
+		idx := i / blockSize * blockSize
+		if idx+blockSize > len(out) {
+			return nil, ErrByteNotFound
+		}
+		target := out[idx : idx+blockSize]
+
+		// a single query encrypts all the possible last bytes of the block
+		known := append(filler, secret...)
+		known = known[len(known)-(blockSize-1):]
+		for k := 0; k < 256; k++ {
+			copy(dictionary[k*blockSize:], known)
+			dictionary[k*blockSize+blockSize-1] = byte(k)
+		}
+		out, err = query(dictionary)
+		if err != nil {
+			return nil, err
+		}
+		found := -1
+		for k := 0; k < 256 && (k+1)*blockSize <= len(out); k++ {
+			if bytes.Equal(out[k*blockSize:(k+1)*blockSize], target) {
+				found = k
+				break
+			}
+		}
+		if found < 0 {
+			return secret, ErrByteNotFound
+		}
+		secret = append(secret, byte(found))
+	}
+	return secret, nil
+}
+
+// ecbSecretLen finds the length of the secret by growing the input until a padding block gets added.
+func ecbSecretLen(query func([]byte) ([]byte, error), blockSize int) (int, error) {
+	out, err := query(nil)
+	if err != nil {
+		return 0, err
+	}
+	initial := len(out)
+	for i := 1; i <= blockSize; i++ {
+		out, err := query(bytes.Repeat([]byte{fillerByte}, i))
+		if err != nil {
+			return 0, err
+		}
+		if len(out) > initial {
+			return initial - i, nil
+		}
+	}
+	return 0, ErrBlockSize
+}
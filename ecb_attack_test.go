@@ -0,0 +1,99 @@
+package kripto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"math/rand"
+	"testing"
+)
+
+var ecbAttackSecret = []byte("Rollin' down the avenue with the top down,\nthe bass so loud the windows shake\x00\x01 and nobody knows the key\n")
+
+// ecbOracle returns an oracle encrypting prefix || input || secret using AES-128 in ECB mode
+// with a random key, prefixFn gives the prefix of each query.
+func ecbOracle(t *testing.T, rnd *rand.Rand, prefixFn func() []byte, secret []byte) Oracle {
+	key := make([]byte, aes.BlockSize)
+	rnd.Read(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func(input []byte) []byte {
+		data := append(append(prefixFn(), input...), secret...)
+		data = pkcs7Pad(data, aes.BlockSize)
+		out := make([]byte, len(data))
+		NewECBEncrypter(block).CryptBlocks(out, data)
+		return out
+	}
+}
+
+func randomBytes(rnd *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	rnd.Read(b)
+	return b
+}
+
+func TestDetectBlockSize(t *testing.T) {
+	rnd := rand.New(rand.NewSource(12))
+	oracle := ecbOracle(t, rnd, func() []byte { return nil }, ecbAttackSecret)
+	if bs, err := DetectBlockSize(oracle); err != nil || bs != aes.BlockSize {
+		t.Fatalf("expected a block size of %d but got %d (%v)\n", aes.BlockSize, bs, err)
+	}
+}
+
+func TestBreakECBByteAtATime(t *testing.T) {
+	rnd := rand.New(rand.NewSource(12))
+	oracle := ecbOracle(t, rnd, func() []byte { return nil }, ecbAttackSecret)
+	if o, err := BreakECBByteAtATime(oracle); err != nil || string(o) != string(ecbAttackSecret) {
+		t.Fatalf("expected to recover\n%q\nbut got\n%q (%v)\n", ecbAttackSecret, o, err)
+	}
+}
+
+func TestBreakECBByteAtATimeWithPrefix(t *testing.T) {
+	rnd := rand.New(rand.NewSource(14))
+	testCases := []struct {
+		name     string
+		prefixFn func() []byte
+	}{
+		{"no prefix", func() []byte { return nil }},
+		{"block prefix", func() []byte { return make([]byte, aes.BlockSize) }},
+	}
+	for _, n := range []int{1, 7, 15, 17, 40} {
+		prefix := randomBytes(rnd, n)
+		testCases = append(testCases, struct {
+			name     string
+			prefixFn func() []byte
+		}{"fixed prefix", func() []byte { return prefix }})
+	}
+	testCases = append(testCases, struct {
+		name     string
+		prefixFn func() []byte
+	}{"changing prefix", func() []byte { return randomBytes(rnd, rnd.Intn(40)) }})
+
+	for i, tc := range testCases {
+		t.Logf("test case %d - %s\n", i, tc.name)
+		oracle := ecbOracle(t, rnd, tc.prefixFn, ecbAttackSecret)
+		if o, err := BreakECBByteAtATimeWithPrefix(oracle); err != nil || string(o) != string(ecbAttackSecret) {
+			t.Fatalf("expected to recover\n%q\nbut got\n%q (%v)\n", ecbAttackSecret, o, err)
+		}
+	}
+}
+
+func TestBreakECBByteAtATimeNotECB(t *testing.T) {
+	block, err := aes.NewCipher(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oracle := func(input []byte) []byte {
+		data := pkcs7Pad(append(input, ecbAttackSecret...), aes.BlockSize)
+		out := make([]byte, len(data))
+		cipher.NewCBCEncrypter(block, commonIV).CryptBlocks(out, data)
+		return out
+	}
+	if _, err := BreakECBByteAtATime(oracle); err != ErrNotECB {
+		t.Fatalf("expected %v but got %v\n", ErrNotECB, err)
+	}
+	if _, err := BreakECBByteAtATimeWithPrefix(oracle); err != ErrNotECB {
+		t.Fatalf("expected %v but got %v\n", ErrNotECB, err)
+	}
+}
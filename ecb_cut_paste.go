@@ -0,0 +1,175 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"strings"
+)
+
+// ErrPrefixLen is returned when the length of the data an oracle adds before the attacker input can't be found.
+var ErrPrefixLen = errors.New("kripto: can't find the oracle prefix length")
+
+// ECBForger forges ECB ciphertexts by cutting and pasting the blocks an ECB oracle encrypts
+// (https://cryptopals.com/sets/2/challenges/13).
+// The oracle must add the same data before the attacker input on each query.
+type ECBForger struct {
+	Oracle    Oracle
+	BlockSize int
+	// PrefixLen is the length of the data the oracle adds before the attacker input.
+	PrefixLen int
+}
+
+// NewECBForger discovers the block size of the oracle, confirms it encrypts in ECB mode
+// and finds where the attacker input starts in the plaintext.
+func NewECBForger(oracle Oracle) (*ECBForger, error) {
+	blockSize, err := DetectBlockSize(oracle)
+	if err != nil {
+		return nil, err
+	}
+	if DetectBlockMode(oracle, blockSize) != ModeECB {
+		return nil, ErrNotECB
+	}
+	prefixLen, err := ECBPrefixLen(oracle, blockSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ECBForger{Oracle: oracle, BlockSize: blockSize, PrefixLen: prefixLen}, nil
+}
+
+// ECBPrefixLen finds the length of the data an ECB oracle adds before the attacker input.
+// The input is made of a growing filler followed by 2 identical blocks, the first pair of identical
+// consecutive ciphertext blocks tells where the blocks (and thus the input) are.
+// It is checked using a different block value so identical blocks in the oracle data can't fool it.
+func ECBPrefixLen(oracle Oracle, blockSize int) (int, error) {
+	for pad := 0; pad < blockSize; pad++ {
+		filler := bytes.Repeat([]byte{fillerByte}, pad)
+		first := identicalBlocks(oracle(append(filler, bytes.Repeat([]byte{'B'}, 2*blockSize)...)), blockSize)
+		if first < 0 {
+			continue
+		}
+		if second := identicalBlocks(oracle(append(filler, bytes.Repeat([]byte{'C'}, 2*blockSize)...)), blockSize); second != first {
+			continue
+		}
+		if prefixLen := first*blockSize - pad; prefixLen >= 0 {
+			return prefixLen, nil
+		}
+	}
+	return 0, ErrPrefixLen
+}
+
+// identicalBlocks returns the index of the first block identical to the next one, -1 if none.
+func identicalBlocks(data []byte, blockSize int) int {
+	for i := 0; i+2*blockSize <= len(data); i += blockSize {
+		if bytes.Equal(data[i:i+blockSize], data[i+blockSize:i+2*blockSize]) {
+			return i / blockSize
+		}
+	}
+	return -1
+}
+
+// FillerLen returns the length of the input that makes the plaintext end on a block boundary
+// after bytes following the input (for instance the "&uid=10&role=" part of a profile cookie,
+// so that the role value starts a new block).
+func (f *ECBForger) FillerLen(after int) int {
+	return (f.BlockSize - (f.PrefixLen+after)%f.BlockSize) % f.BlockSize
+}
+
+// AlignedInput returns the input placing the chosen plaintext at the start of a block
+// and the index of this block.
+func (f *ECBForger) AlignedInput(chosen []byte) (input []byte, block int) {
+	filler := f.FillerLen(0)
+	input = append(bytes.Repeat([]byte{fillerByte}, filler), chosen...)
+	return input, (f.PrefixLen + filler) / f.BlockSize
+}
+
+// EncryptBlocks returns the ciphertext blocks of the chosen plaintext, encrypted at the start of a block.
+// The chosen plaintext should be made of full blocks (pad it with PKCS7Pad to forge the last block).
+func (f *ECBForger) EncryptBlocks(chosen []byte) []byte {
+	input, block := f.AlignedInput(chosen)
+	blocks := (len(chosen) + f.BlockSize - 1) / f.BlockSize
+	return ECBCutBlocks(f.Oracle(input), f.BlockSize, block, block+blocks)
+}
+
+// ECBCutBlocks returns a copy of the blocks of the ciphertext from the block index from to the block index to (excluded).
+// The indexes are truncated to the ciphertext blocks.
+func ECBCutBlocks(ciphertext []byte, blockSize, from, to int) []byte {
+	blocks := len(ciphertext) / blockSize
+	if to > blocks {
+		to = blocks
+	}
+	if from < 0 {
+		from = 0
+	}
+	if from >= to {
+		return []byte{}
+	}
+	return append([]byte{}, ciphertext[from*blockSize:to*blockSize]...)
+}
+
+// ECBSplice concatenates ciphertext pieces made of full blocks into a new ciphertext.
+// ECB encrypts each block independently, so the result decrypts to the concatenation of the pieces plaintexts.
+func ECBSplice(pieces ...[]byte) []byte {
+	return bytes.Join(pieces, nil)
+}
+
+// ProfileFor encodes the profile of the user with the given email as a cookie such as
+// email=foo@bar.com&uid=10&role=user. The & and = characters are removed from the email.
+func ProfileFor(email string) string {
+	email = strings.NewReplacer("&", "", "=", "").Replace(email)
+	return "email=" + email + "&uid=10&role=user"
+}
+
+// ParseKeyValues parses a cookie such as foo=bar&baz=qux, the last value wins when a key is repeated.
+func ParseKeyValues(cookie string) map[string]string {
+	values := map[string]string{}
+	for _, pair := range strings.Split(cookie, "&") {
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) == 1 {
+			values[kv[0]] = ""
+			continue
+		}
+		values[kv[0]] = kv[1]
+	}
+	return values
+}
+
+// ProfileService is a stand-in for a web service keeping the user profile in a cookie
+// encrypted using AES in ECB mode, a classic target for ECB cut-and-paste attacks.
+type ProfileService struct {
+	block cipher.Block
+}
+
+// NewProfileService returns a service encrypting its cookies using the AES key.
+func NewProfileService(key []byte) (*ProfileService, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &ProfileService{block: block}, nil
+}
+
+// Cookie returns the encrypted profile cookie of the user with the given email (see ProfileFor).
+func (s *ProfileService) Cookie(email string) []byte {
+	return ECBEncrypt(s.block, []byte(ProfileFor(email)))
+}
+
+// Oracle returns the service Cookie method as an Oracle.
+func (s *ProfileService) Oracle() Oracle {
+	return func(input []byte) []byte {
+		return s.Cookie(string(input))
+	}
+}
+
+// Profile decrypts the cookie and returns the profile it contains.
+func (s *ProfileService) Profile(cookie []byte) (map[string]string, error) {
+	plaintext, err := ECBDecrypt(s.block, cookie)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyValues(string(plaintext)), nil
+}
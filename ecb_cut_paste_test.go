@@ -0,0 +1,64 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"reflect"
+	"testing"
+)
+
+func TestProfileFor(t *testing.T) {
+	testCases := []struct {
+		email   string
+		cookie  string
+		profile map[string]string
+	}{
+		{"foo@bar.com", "email=foo@bar.com&uid=10&role=user", map[string]string{"email": "foo@bar.com", "uid": "10", "role": "user"}},
+		{"foo@bar.com&role=admin", "email=foo@bar.comroleadmin&uid=10&role=user", map[string]string{"email": "foo@bar.comroleadmin", "uid": "10", "role": "user"}},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		cookie := ProfileFor(tc.email)
+		if cookie != tc.cookie {
+			t.Fatalf("expected %s\ngot\n%s\n", tc.cookie, cookie)
+		}
+		if profile := ParseKeyValues(cookie); !reflect.DeepEqual(profile, tc.profile) {
+			t.Fatalf("expected %v\ngot\n%v\n", tc.profile, profile)
+		}
+	}
+}
+
+func TestECBForger(t *testing.T) {
+	service, err := NewProfileService(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forger, err := NewECBForger(service.Oracle())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forger.BlockSize != aes.BlockSize || forger.PrefixLen != len("email=") {
+		t.Fatalf("expected a block size of 16 and a prefix of 6 bytes but got %d and %d\n", forger.BlockSize, forger.PrefixLen)
+	}
+
+	// the admin block, padded as the last block of a cookie
+	admin, err := PKCS7Pad([]byte("admin"), forger.BlockSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminBlock := forger.EncryptBlocks(admin)
+
+	// a cookie ending with role= at the end of a block, the last block only holds the role value
+	email := string(bytes.Repeat([]byte{'x'}, forger.FillerLen(len("@bar.com&uid=10&role=")))) + "@bar.com"
+	cookie := service.Cookie(email)
+	head := ECBCutBlocks(cookie, forger.BlockSize, 0, len(cookie)/forger.BlockSize-1)
+
+	profile, err := service.Profile(ECBSplice(head, adminBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile["role"] != "admin" || profile["email"] != email {
+		t.Fatalf("expected to forge an admin profile but got %v\n", profile)
+	}
+}
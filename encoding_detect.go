@@ -0,0 +1,381 @@
+package kripto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"mime/quotedprintable"
+	"net/url"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Encoding is a binary to text encoding that can be detected and decoded.
+type Encoding struct {
+	// Name of the encoding
+	Name string
+	// Detect indicates if the data looks encoded using this encoding.
+	Detect func(data []byte) bool
+	// Decode decodes the data.
+	Decode func(data []byte) ([]byte, error)
+}
+
+func (e *Encoding) String() string {
+	return e.Name
+}
+
+var (
+	// HexEncoding is the hexadecimal encoding (case insensitive, it may be wrapped on several lines
+	// and white spaces are ignored when decoding)
+	HexEncoding = &Encoding{
+		Name:   "hex",
+		Detect: detectHex,
+		Decode: func(data []byte) ([]byte, error) {
+			return hex.DecodeString(string(stripSpaces(data)))
+		},
+	}
+	// Base32Encoding is the standard base32 encoding defined in RFC 4648 (it may be wrapped on several lines)
+	Base32Encoding = &Encoding{
+		Name: "base32",
+		Detect: func(data []byte) bool {
+			return detectBase32(data, base32Alphabet)
+		},
+		Decode: func(data []byte) ([]byte, error) {
+			return DecodeBase32(stripSpaces(data))
+		},
+	}
+	// Base32HexEncoding is the base32 encoding using the extended hex alphabet defined in RFC 4648
+	// (it may be wrapped on several lines)
+	Base32HexEncoding = &Encoding{
+		Name: "base32hex",
+		Detect: func(data []byte) bool {
+			return detectBase32(data, base32HexAlphabet)
+		},
+		Decode: func(data []byte) ([]byte, error) {
+			return DecodeBase32Hex(stripSpaces(data))
+		},
+	}
+	// Base64Encoding is the standard base64 encoding defined in RFC 4648,
+	// padded or not (it may be wrapped on several lines)
+	Base64Encoding = &Encoding{
+		Name: "base64",
+		Detect: func(data []byte) bool {
+			return detectBase64(data, base64Alphabet)
+		},
+		Decode: func(data []byte) ([]byte, error) {
+			return base64.RawStdEncoding.DecodeString(string(bytes.TrimRight(stripSpaces(data), "=")))
+		},
+	}
+	// Base64URLEncoding is the URL and file name safe base64 encoding defined in RFC 4648,
+	// padded or not (it may be wrapped on several lines)
+	Base64URLEncoding = &Encoding{
+		Name: "base64url",
+		Detect: func(data []byte) bool {
+			return detectBase64(data, base64URLAlphabet)
+		},
+		Decode: func(data []byte) ([]byte, error) {
+			return base64.RawURLEncoding.DecodeString(string(bytes.TrimRight(stripSpaces(data), "=")))
+		},
+	}
+	// ASCII85Encoding is the ascii85 (base85) encoding used by Adobe's PostScript and PDF,
+	// it is only detected when delimited by <~ and ~>.
+	ASCII85Encoding = &Encoding{
+		Name:   "ascii85",
+		Detect: detectASCII85,
+		Decode: DecodeASCII85,
+	}
+	// PercentEncoding is the URL percent-encoding defined in RFC 3986
+	PercentEncoding = &Encoding{
+		Name:   "percent",
+		Detect: detectPercent,
+		Decode: func(data []byte) ([]byte, error) {
+			s, err := url.PathUnescape(string(data))
+			return []byte(s), err
+		},
+	}
+	// QuotedPrintableEncoding is the quoted-printable encoding defined in RFC 2045
+	QuotedPrintableEncoding = &Encoding{
+		Name:   "quoted-printable",
+		Detect: detectQuotedPrintable,
+		Decode: func(data []byte) ([]byte, error) {
+			return ioutil.ReadAll(quotedprintable.NewReader(bytes.NewReader(data)))
+		},
+	}
+	// UUEncoding is the Unix-to-Unix encoding, it is only detected with its begin line.
+	UUEncoding = &Encoding{
+		Name:   "uuencode",
+		Detect: detectUUEncode,
+		Decode: decodeUUEncode,
+	}
+)
+
+// Encodings lists the encodings tried by DetectEncoding, from the most specific to the least.
+// Base58 and Z85 aren't detected: their alphabets cover almost any word or number ("HelloWorld"
+// is valid Z85), use DeBase58 or DeZ85 when the data is known to use them.
+var Encodings = []*Encoding{
+	UUEncoding,
+	ASCII85Encoding,
+	HexEncoding,
+	Base32Encoding,
+	Base32HexEncoding,
+	Base64Encoding,
+	Base64URLEncoding,
+	PercentEncoding,
+	QuotedPrintableEncoding,
+}
+
+// maxEncodingLayers is the number of layers AutoDecode peels at most
+const maxEncodingLayers = 16
+
+// minBinaryDecodedLen is the minimum length of binary data decoded by DetectEncoding,
+// shorter tokens like "10pm" or "2024" are words or numbers.
+const minBinaryDecodedLen = 4
+
+var (
+	base64Alphabet    = []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/")
+	base64URLAlphabet = []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_")
+	base32Alphabet    = []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567")
+
+	percentEscape     = regexp.MustCompile(`%[0-9A-Fa-f]{2}`)
+	qpEscape          = regexp.MustCompile(`=([0-9A-F]{2}|\r?\n)`)
+	uuencodeBeginLine = regexp.MustCompile(`^begin [0-7]{3,4} \S`)
+)
+
+// DetectEncoding returns the first encoding of Encodings detected and properly decoding the data
+// to something plausible, nil if the data doesn't look encoded.
+// Plain words often only use hex, base32 or base64 characters ("deadbeef", "password", "10pm"),
+// so the data is only considered encoded when it decodes to text, or to binary data when it
+// mixes letters and digits or symbols the way encoded binary data does.
+func DetectEncoding(data []byte) *Encoding {
+	for _, enc := range Encodings {
+		if !enc.Detect(data) {
+			continue
+		}
+		if decoded, err := enc.Decode(data); err == nil && len(decoded) > 0 && plausibleDecoding(data, decoded) {
+			return enc
+		}
+	}
+	return nil
+}
+
+// PeelEncodings decodes the data as long as it looks encoded (up to maxLayers times)
+// and returns the decoded data as well as the encodings that were applied, outermost first.
+// For instance the base64 encoding of hex encoded data returns the decoded data and [base64 hex].
+func PeelEncodings(data []byte, maxLayers int) ([]byte, []*Encoding) {
+	chain := []*Encoding{}
+	for len(chain) < maxLayers {
+		enc := DetectEncoding(data)
+		if enc == nil {
+			break
+		}
+		decoded, err := enc.Decode(data)
+		if err != nil {
+			break
+		}
+		data = decoded
+		chain = append(chain, enc)
+	}
+	return data, chain
+}
+
+// AutoDecode is a DataProcessFn peeling all the encoding layers of the data (see PeelEncodings).
+func AutoDecode(data []byte) []byte {
+	decoded, _ := PeelEncodings(data, maxEncodingLayers)
+	return decoded
+}
+
+// plausibleDecoding checks that the decoded data is a plausible decoding of data.
+// Binary data is accepted when it is at least minBinaryDecodedLen bytes long and data uses both
+// letters and digits or symbols like the encodings of binary data almost always do: a plain word
+// or a number needs to decode to text.
+func plausibleDecoding(data, decoded []byte) bool {
+	if looksLikeText(decoded) {
+		return true
+	}
+	if len(decoded) < minBinaryDecodedLen {
+		return false
+	}
+	var letter, other bool
+	for _, b := range stripSpaces(data) {
+		if IsASCIILetter(b) {
+			letter = true
+		} else {
+			other = true
+		}
+	}
+	return letter && other
+}
+
+// looksLikeText checks that the data is printable UTF-8 text mostly made of letters, digits and spaces.
+func looksLikeText(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	var runes, alnum int
+	for _, r := range string(data) {
+		runes++
+		switch {
+		case r < utf8.RuneSelf && !IsPrintable(byte(r)):
+			return false
+		case r >= utf8.RuneSelf && !unicode.IsPrint(r):
+			return false
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r):
+			alnum++
+		}
+	}
+	return alnum*2 > runes
+}
+
+// stripSpaces removes the white spaces used to wrap encoded data.
+func stripSpaces(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for _, b := range data {
+		switch b {
+		case ' ', '\t', '\r', '\n':
+		default:
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// unwrap removes the line breaks used to wrap encoded data on several lines,
+// the white spaces inside a line are kept: they separate words, not encoded chunks.
+func unwrap(data []byte) []byte {
+	data = bytes.TrimSpace(data)
+	out := make([]byte, 0, len(data))
+	for _, b := range data {
+		if b != '\r' && b != '\n' {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// onlyBytesFrom checks that all the bytes of data are part of the alphabet.
+func onlyBytesFrom(data, alphabet []byte) bool {
+	for _, b := range data {
+		if bytes.IndexByte(alphabet, b) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func detectHex(data []byte) bool {
+	data = unwrap(data)
+	return len(data) >= 2 && len(data)%2 == 0 && onlyBytesFrom(data, []byte("0123456789abcdefABCDEF"))
+}
+
+func detectBase32(data, alphabet []byte) bool {
+	data = unwrap(data)
+	if len(data) < 8 || len(data)%8 != 0 {
+		return false
+	}
+	trimmed := bytes.TrimRight(data, "=")
+	switch len(data) - len(trimmed) {
+	case 0, 1, 3, 4, 6:
+	default:
+		return false
+	}
+	return onlyBytesFrom(trimmed, alphabet)
+}
+
+func detectBase64(data, alphabet []byte) bool {
+	data = unwrap(data)
+	trimmed := bytes.TrimRight(data, "=")
+	padding := len(data) - len(trimmed)
+	if len(trimmed) < 4 || padding > 2 || len(trimmed)%4 == 1 {
+		return false
+	}
+	if padding > 0 && len(data)%4 != 0 {
+		return false
+	}
+	return onlyBytesFrom(trimmed, alphabet)
+}
+
+// ascii85 data is delimited by <~ and ~>
+func trimASCII85(data []byte) ([]byte, bool) {
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte("<~")) || !bytes.HasSuffix(data, []byte("~>")) {
+		return nil, false
+	}
+	return data[2 : len(data)-2], true
+}
+
+func detectASCII85(data []byte) bool {
+	body, ok := trimASCII85(data)
+	if !ok {
+		return false
+	}
+	for _, b := range stripSpaces(body) {
+		if (b < '!' || b > 'u') && b != 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func detectPercent(data []byte) bool {
+	escapes := percentEscape.FindAllIndex(data, -1)
+	return len(escapes) > 0 && len(escapes) == bytes.Count(data, []byte("%"))
+}
+
+func detectQuotedPrintable(data []byte) bool {
+	escapes := qpEscape.FindAllIndex(data, -1)
+	if len(escapes) == 0 || len(escapes) != bytes.Count(data, []byte("=")) {
+		return false
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		// quoted-printable lines are limited to 76 characters
+		if len(bytes.TrimRight(line, "\r")) > 76 {
+			return false
+		}
+	}
+	return true
+}
+
+func detectUUEncode(data []byte) bool {
+	return uuencodeBeginLine.Match(bytes.TrimLeft(data, "\r\n"))
+}
+
+// decodeUUEncode decodes the first uuencoded file found in data.
+func decodeUUEncode(data []byte) ([]byte, error) {
+	lines := bytes.Split(bytes.Replace(bytes.TrimLeft(data, "\r\n"), []byte("\r\n"), []byte("\n"), -1), []byte("\n"))
+	if len(lines) == 0 || !uuencodeBeginLine.Match(lines[0]) {
+		return nil, errors.New("kripto: missing uuencode begin line")
+	}
+	out := []byte{}
+	for _, line := range lines[1:] {
+		if bytes.Equal(line, []byte("end")) {
+			return out, nil
+		}
+		if len(line) == 0 {
+			continue
+		}
+		n := int((line[0] - ' ') & 0x3f)
+		if n == 0 {
+			continue
+		}
+		chars := line[1:]
+		if len(chars) < (n+2)/3*4 {
+			return nil, errors.New("kripto: truncated uuencode line")
+		}
+		decoded := make([]byte, 0, n+2)
+		for i := 0; i+4 <= len(chars) && len(decoded) < n; i += 4 {
+			var v uint32
+			for _, c := range chars[i : i+4] {
+				if c < ' ' || c > '`' {
+					return nil, errors.New("kripto: invalid uuencode character")
+				}
+				v = v<<6 | uint32((c-' ')&0x3f)
+			}
+			decoded = append(decoded, byte(v>>16), byte(v>>8), byte(v))
+		}
+		out = append(out, decoded[:n]...)
+	}
+	return nil, errors.New("kripto: missing uuencode end line")
+}
@@ -0,0 +1,85 @@
+package kripto
+
+import (
+	"encoding/ascii85"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func TestPeelEncodings(t *testing.T) {
+	plain := []byte("Crypto is fun, isn't it?")
+	b64 := func(data []byte) []byte { return []byte(base64.StdEncoding.EncodeToString(data)) }
+	hx := func(data []byte) []byte { return []byte(hex.EncodeToString(data)) }
+	a85 := func(data []byte) []byte {
+		dst := make([]byte, ascii85.MaxEncodedLen(len(data)))
+		return append(append([]byte("<~"), dst[:ascii85.Encode(dst, data)]...), "~>"...)
+	}
+
+	testCases := []struct {
+		input  []byte
+		output string
+		chain  []string
+	}{
+		{plain, string(plain), []string{}},
+		{hx(plain), string(plain), []string{"hex"}},
+		{[]byte("43727970746f2069732066756e2c2069736e277420\n69743f\n"), string(plain), []string{"hex"}},
+		{b64(plain), string(plain), []string{"base64"}},
+		{[]byte(base64.RawURLEncoding.EncodeToString([]byte{0xfb, 0xff, 0xbf, 0xfe})), "\xfb\xff\xbf\xfe", []string{"base64url"}},
+		{[]byte(base32.StdEncoding.EncodeToString(plain)), string(plain), []string{"base32"}},
+		{[]byte(base32.HexEncoding.EncodeToString(plain)), string(plain), []string{"base32hex"}},
+		{a85(plain), string(plain), []string{"ascii85"}},
+		{[]byte("Caf%C3%A9%20au%20lait"), "Café au lait", []string{"percent"}},
+		{[]byte("Caf=C3=A9 au lait, le caf=C3=A9 au lait est =\r\nbon"), "Café au lait, le café au lait est bon", []string{"quoted-printable"}},
+		{[]byte("begin 644 cat.txt\n#0V%T\n`\nend\n"), "Cat", []string{"uuencode"}},
+		// base64 of hex of base64
+		{b64(hx(b64(plain))), string(plain), []string{"base64", "hex", "base64"}},
+		{a85(b64(hx(plain))), string(plain), []string{"ascii85", "base64", "hex"}},
+		// plain words only made of hex, base32 or base64 characters
+		{[]byte("password"), "password", []string{}},
+		{[]byte("HelloWorld"), "HelloWorld", []string{}},
+		{[]byte("PASSWORD"), "PASSWORD", []string{}},
+		{[]byte("deadbeef"), "deadbeef", []string{}},
+		{[]byte("cafe"), "cafe", []string{}},
+		{b64([]byte("password")), "password", []string{"base64"}},
+		{b64([]byte("HelloWorld")), "HelloWorld", []string{"base64"}},
+		{[]byte(base32.StdEncoding.EncodeToString([]byte("PASSWORD"))), "PASSWORD", []string{"base32"}},
+		{b64([]byte("deadbeef")), "deadbeef", []string{"base64"}},
+		{hx([]byte("cafe")), "cafe", []string{"hex"}},
+		// text with digits isn't decoded, the spaces between words aren't line wrapping
+		{[]byte("meet me at 10pm"), "meet me at 10pm", []string{}},
+		{[]byte("Room 42 is free"), "Room 42 is free", []string{}},
+		{[]byte("order 1234 shipped"), "order 1234 shipped", []string{}},
+		{[]byte("10pm"), "10pm", []string{}},
+		{[]byte("12345678"), "12345678", []string{}},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, chain := PeelEncodings(tc.input, maxEncodingLayers)
+		names := []string{}
+		for _, enc := range chain {
+			names = append(names, enc.Name)
+		}
+		if string(o) != tc.output || !reflect.DeepEqual(names, tc.chain) {
+			t.Fatalf("expected to get:\n'%s' using %v\nbut got\n'%s'\nusing %v\n", tc.output, tc.chain, o, names)
+		}
+	}
+}
+
+func TestDetectEncodingBinary(t *testing.T) {
+	data := SingleCharXor([]byte("Crypto is fun, isn't it?"), 'M')
+	if enc := DetectEncoding(data); enc != nil {
+		t.Fatalf("expected binary data not to look encoded but got %s\n", enc)
+	}
+}
+
+func TestBreakSingleCharXorAutoDecode(t *testing.T) {
+	xord := SingleCharXor([]byte("Crypto is fun, isn't it?"), 42)
+	input := base64.StdEncoding.EncodeToString([]byte(hex.EncodeToString(xord)))
+	if o, k := BreakSingleCharXor([]byte(input), AutoDecode, &EnglishScorer{WithSpace: true}); string(o) != "Crypto is fun, isn't it?" || k != 42 {
+		t.Fatalf("expected to get 'Crypto is fun, isn't it?' using key 42 but got\n'%s'\nfrom key %d\n", o, k)
+	}
+}
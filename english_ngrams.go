@@ -0,0 +1,10047 @@
+// Code generated by gen_ngrams.go; DO NOT EDIT.
+
+package kripto
+
+// englishTrigrams lists the count of each 3-gram seen in the reference English text.
+const englishTrigrams = `
+ 18 1
+ 19 3
+ a  432
+ a, 1
+ ab 41
+ ac 23
+ ad 19
+ af 21
+ ag 28
+ ah 7
+ ai 30
+ al 90
+ am 9
+ an 471
+ ap 15
+ ar 105
+ as 64
+ at 73
+ au 7
+ av 6
+ aw 14
+ ax 1
+ ba 53
+ be 200
+ bi 17
+ bl 12
+ bo 45
+ br 44
+ bu 77
+ by 54
+ ca 146
+ ce 23
+ ch 87
+ ci 20
+ cl 45
+ co 194
+ cr 23
+ cu 23
+ cy 3
+ da 67
+ de 66
+ di 69
+ do 63
+ dr 30
+ du 9
+ dw 2
+ e  1
+ e, 1
+ e. 1
+ ea 59
+ ed 2
+ ef 5
+ eg 2
+ ei 5
+ el 13
+ em 5
+ en 48
+ eq 5
+ er 3
+ es 2
+ eu 4
+ ev 93
+ ex 38
+ ey 4
+ fa 53
+ fe 38
+ fi 92
+ fl 33
+ fo 164
+ fr 86
+ fu 11
+ ga 32
+ ge 13
+ gi 13
+ gl 7
+ go 38
+ gr 59
+ gu 8
+ gy 2
+ ha 153
+ he 105
+ hi 37
+ ho 69
+ hu 17
+ i  24
+ ic 5
+ id 10
+ if 21
+ il 2
+ im 28
+ in 309
+ ir 2
+ is 211
+ it 179
+ ja 1
+ je 1
+ jo 8
+ ju 14
+ ka 2
+ ke 33
+ ki 17
+ kn 28
+ l, 1
+ la 80
+ le 94
+ li 97
+ lo 84
+ lu 5
+ ma 138
+ me 76
+ mi 39
+ mo 155
+ mu 38
+ my 5
+ n. 1
+ na 8
+ ne 84
+ ni 21
+ no 104
+ nu 20
+ o' 3
+ o, 1
+ ob 5
+ oc 2
+ of 345
+ oi 2
+ ol 18
+ on 160
+ op 21
+ or 60
+ ot 31
+ ou 36
+ ov 25
+ ow 14
+ ox 2
+ pa 77
+ pe 75
+ ph 21
+ pi 24
+ pl 70
+ po 47
+ pr 74
+ pu 28
+ qu 23
+ ra 31
+ re 155
+ rh 1
+ ri 43
+ ro 38
+ ru 27
+ sa 85
+ sc 33
+ se 114
+ sh 88
+ si 79
+ sk 11
+ sl 29
+ sm 50
+ sn 2
+ so 130
+ sp 44
+ sq 5
+ st 168
+ su 64
+ sw 10
+ sy 12
+ t, 1
+ ta 59
+ te 52
+ th 1450
+ ti 48
+ to 370
+ tr 56
+ tu 21
+ tw 32
+ ty 2
+ ug 1
+ um 2
+ un 34
+ up 20
+ us 68
+ va 12
+ ve 21
+ vi 16
+ vo 10
+ wa 155
+ we 123
+ wh 192
+ wi 140
+ wo 100
+ wr 32
+ ya 1
+ ye 48
+ yo 52
+'cl 3
+'s  7
+, a 189
+, b 62
+, c 20
+, d 4
+, e 14
+, f 23
+, g 3
+, h 10
+, i 30
+, j 2
+, k 2
+, l 13
+, m 21
+, n 5
+, o 20
+, p 16
+, q 2
+, r 7
+, s 53
+, t 116
+, u 4
+, w 57
+, y 6
+. a 64
+. b 20
+. c 9
+. d 6
+. e 14
+. f 25
+. g 7
+. h 12
+. i 74
+. j 2
+. k 3
+. l 14
+. m 28
+. n 5
+. o 30
+. p 19
+. r 7
+. s 35
+. t 155
+. u 5
+. v 3
+. w 43
+. y 11
+0,  1
+0s. 1
+187 1
+193 1
+194 1
+199 1
+30, 1
+40s 1
+6,  1
+7 a 1
+76, 1
+876 1
+930 1
+940 1
+97  1
+997 1
+: a 3
+: f 1
+: h 2
+: t 6
+: w 1
+? h 1
+? i 1
+? o 1
+? t 1
+? w 3
+a a 6
+a b 23
+a c 32
+a d 21
+a f 38
+a g 21
+a h 12
+a i 6
+a k 6
+a l 40
+a m 27
+a n 18
+a o 3
+a p 26
+a q 3
+a r 22
+a s 85
+a t 26
+a v 6
+a w 33
+a y 9
+a,  8
+a.  4
+ab  1
+abb 1
+abe 11
+abi 8
+abl 35
+abo 38
+abr 1
+abs 2
+abu 1
+aby 1
+acc 11
+ace 34
+ach 52
+ack 30
+acr 8
+act 16
+acu 1
+ad  68
+ad, 9
+ad. 9
+ada 2
+add 4
+ade 29
+adi 7
+adl 2
+adm 1
+adn 1
+adp 1
+ads 7
+adu 7
+adv 7
+ady 5
+aes 1
+af  4
+af, 1
+af. 1
+afe 9
+aff 2
+aft 19
+ag  1
+ag, 2
+ag. 1
+aga 16
+age 65
+agi 2
+ago 5
+agr 2
+ags 2
+agu 2
+aha 2
+ahe 7
+aid 11
+aig 2
+ail 12
+aim 2
+ain 70
+air 40
+ait 7
+ajo 2
+ak  7
+ak. 1
+ake 63
+aki 8
+akn 3
+aks 1
+al  46
+al, 1
+al. 4
+ala 5
+alc 4
+ale 5
+alf 5
+ali 5
+alk 23
+all 135
+alm 12
+alo 12
+alp 9
+alr 3
+als 29
+alt 26
+alu 4
+alv 3
+alw 9
+aly 4
+am  15
+am' 1
+am, 3
+am. 1
+ama 2
+amb 8
+ame 53
+ami 9
+amm 5
+amo 7
+amp 7
+ams 7
+an  172
+an. 1
+ana 7
+anc 19
+and 464
+ane 20
+ang 53
+ani 7
+ank 16
+ann 10
+ano 7
+ans 18
+ant 50
+any 63
+ap  3
+apa 4
+ape 19
+aph 12
+api 1
+apo 2
+app 20
+apr 1
+aps 2
+apt 2
+apy 1
+ar  41
+ar, 5
+ar. 8
+ara 5
+arb 5
+arc 6
+ard 53
+are 109
+arg 20
+ari 10
+ark 23
+arl 14
+arm 23
+arn 20
+aro 11
+arr 20
+ars 37
+art 52
+arv 3
+ary 15
+as  151
+as, 1
+as. 3
+asa 1
+ase 12
+ash 3
+asi 12
+ask 12
+asl 1
+aso 7
+ass 22
+ast 51
+asu 3
+asy 5
+at  268
+at, 4
+ata 5
+atc 15
+ate 82
+atf 1
+ath 33
+ati 51
+atm 4
+ato 12
+ats 7
+att 18
+atu 5
+auc 1
+aug 8
+aur 2
+aus 30
+aut 7
+ava 1
+ave 74
+avi 9
+avo 5
+avy 3
+aw  2
+awa 11
+awe 3
+awn 5
+aws 1
+ax  2
+ax. 1
+axe 4
+axi 1
+axy 1
+ay  75
+ay, 18
+ay. 10
+ay: 1
+ay? 1
+aya 1
+aye 19
+ayi 2
+ays 34
+aze 1
+b i 1
+b o 1
+b t 1
+b w 2
+b,  2
+b.  1
+bab 3
+bac 12
+bad 4
+bag 5
+bak 7
+bal 10
+ban 13
+bar 1
+bas 6
+bba 1
+bbo 1
+be  47
+bea 10
+bec 43
+bed 3
+bee 28
+bef 19
+beg 9
+beh 12
+bei 1
+bel 10
+ben 2
+ber 34
+bes 9
+bet 25
+bey 1
+bic 6
+big 2
+bil 3
+bin 1
+bir 3
+bis 3
+bit 9
+bje 3
+bla 3
+ble 60
+bli 3
+blo 7
+blu 2
+bly 3
+boa 6
+bod 11
+bol 3
+bon 5
+boo 17
+bor 6
+bos 1
+bot 9
+bou 33
+bov 5
+bow 1
+box 4
+boy 1
+bra 21
+bre 18
+bri 11
+bro 7
+bru 1
+bs  3
+bs, 1
+bse 1
+bsi 4
+bso 2
+bta 1
+bui 17
+bul 1
+buo 1
+bur 4
+bus 10
+but 42
+buy 3
+by  55
+c a 1
+c c 2
+c d 2
+c e 1
+c i 2
+c l 1
+c m 1
+c s 1
+c t 1
+c,  3
+c.  1
+ca  1
+ca, 1
+cab 5
+cad 1
+cae 1
+caf 3
+cak 2
+cal 29
+cam 17
+can 64
+cap 1
+car 34
+cas 6
+cat 7
+cau 29
+cce 10
+cci 2
+cco 3
+ccu 1
+ce  71
+ce, 8
+ce. 13
+ce: 1
+cea 2
+ced 7
+cee 1
+cef 1
+cei 5
+cel 1
+cen 25
+cep 7
+cer 7
+ces 47
+ch  93
+ch, 4
+ch. 3
+cha 41
+che 46
+chi 38
+cho 24
+cht 2
+chu 3
+chw 1
+chy 1
+cia 3
+cid 7
+cie 8
+cif 1
+cil 3
+cin 5
+cio 2
+cip 8
+cir 2
+cis 2
+cit 15
+ck  29
+ck, 7
+cke 11
+ckg 1
+cki 2
+ckl 7
+ckm 1
+cks 9
+ckw 1
+cla 8
+cle 20
+cli 13
+clo 19
+clu 7
+coa 4
+cod 2
+cof 3
+cog 1
+coi 5
+col 17
+com 83
+con 33
+coo 12
+cop 6
+cor 6
+cos 3
+cou 50
+cov 12
+cra 7
+cre 23
+cri 11
+cro 13
+cru 2
+cry 8
+cs  5
+cs, 1
+ct  14
+ct. 2
+ct? 1
+cta 2
+cte 7
+cti 20
+ctl 6
+cto 3
+ctr 3
+cts 11
+ctu 4
+cui 3
+cul 11
+cup 1
+cur 9
+cus 10
+cut 8
+cuu 1
+cy  1
+cyc 9
+d a 102
+d b 49
+d c 43
+d d 25
+d e 29
+d f 48
+d g 11
+d h 23
+d i 66
+d k 5
+d l 34
+d m 48
+d n 27
+d o 61
+d p 29
+d q 1
+d r 18
+d s 68
+d t 235
+d u 10
+d v 4
+d w 66
+d y 8
+d,  52
+d.  66
+d:  1
+dab 1
+dai 1
+dam 1
+dan 6
+dap 1
+dar 9
+dat 8
+dau 1
+daw 1
+day 59
+dch 1
+dde 6
+ddi 1
+ddl 3
+ddr 1
+ddy 1
+de  39
+de, 4
+de. 8
+dea 16
+dec 10
+ded 20
+dee 6
+def 4
+del 3
+dem 1
+den 26
+dep 7
+der 45
+des 22
+det 8
+dev 7
+dfn 1
+dfu 2
+dge 7
+dhi 1
+dia 5
+dic 6
+did 3
+die 8
+dif 20
+din 43
+dio 3
+dir 5
+dis 29
+dit 2
+div 3
+dle 7
+dly 5
+dmi 1
+dmo 3
+dne 2
+do  13
+do. 3
+doc 1
+doe 11
+dog 3
+doi 3
+dom 4
+don 1
+doo 2
+dou 9
+dow 24
+dpa 1
+dph 1
+dra 9
+dre 31
+dri 9
+dro 8
+dry 1
+ds  67
+ds, 9
+ds. 6
+dua 1
+duc 11
+dul 7
+dur 8
+dus 1
+dva 6
+dve 1
+dwe 1
+dwi 1
+dww 1
+dy  23
+dy, 2
+dy. 1
+e 1 1
+e a 185
+e b 103
+e c 129
+e d 75
+e e 68
+e f 104
+e g 41
+e h 73
+e i 121
+e j 3
+e k 22
+e l 80
+e m 108
+e n 56
+e o 151
+e p 115
+e q 5
+e r 95
+e s 213
+e t 259
+e u 14
+e v 19
+e w 162
+e y 18
+e's 1
+e,  88
+e.  100
+e:  3
+e?  1
+ea  13
+ea, 2
+ea. 1
+eac 45
+ead 67
+eag 1
+eak 17
+eal 23
+eam 19
+ean 17
+eap 2
+ear 113
+eas 44
+eat 57
+eau 1
+eav 19
+ebo 2
+ebs 4
+eca 37
+ece 18
+ech 1
+eci 12
+eck 5
+ecl 1
+eco 25
+ecr 8
+ect 44
+ecu 2
+ed  275
+ed, 13
+ed. 21
+ede 4
+edg 4
+edi 10
+edl 1
+edn 1
+edr 2
+eds 13
+edu 4
+ee  28
+ee, 3
+eed 35
+eek 13
+eel 8
+eem 14
+een 46
+eep 39
+eer 8
+ees 14
+eet 16
+ef. 1
+efe 8
+eff 5
+efl 2
+efo 19
+efr 2
+eft 7
+efu 12
+ega 3
+ege 6
+egg 1
+egi 8
+egr 2
+egs 1
+egu 4
+egy 1
+eha 6
+ehi 6
+eho 1
+eig 11
+eil 1
+ein 2
+eip 1
+eir 47
+eit 1
+eiv 3
+ek  4
+ek. 1
+ekd 1
+eke 1
+eks 6
+el  11
+el, 2
+ela 8
+elc 1
+eld 9
+ele 20
+elf 6
+eli 12
+ell 38
+elo 7
+elp 15
+els 13
+elt 4
+elv 5
+ely 15
+em  29
+em, 6
+em. 3
+ema 8
+emb 12
+eme 18
+emi 4
+emo 6
+emp 9
+ems 20
+emy 2
+en  194
+en, 19
+en. 11
+ena 1
+enc 28
+end 52
+ene 14
+eng 17
+eni 21
+enj 2
+enl 3
+eno 8
+ens 12
+ent 141
+enu 2
+env 1
+eo  1
+eon 10
+eop 57
+eor 2
+ep  24
+ep, 4
+ep. 2
+epa 9
+epe 14
+eph 5
+epi 6
+epl 6
+epo 2
+epp 1
+epr 1
+eps 3
+ept 14
+equ 11
+er  314
+er' 1
+er, 41
+er. 40
+er: 1
+era 29
+erb 3
+erc 3
+ere 125
+erf 9
+erg 3
+erh 1
+eri 15
+erl 2
+erm 3
+ern 22
+ero 5
+erp 1
+err 3
+ers 143
+ert 9
+erv 10
+erw 2
+ery 74
+es  295
+es, 42
+es. 33
+es: 2
+esa 1
+esc 8
+esd 1
+ese 21
+esh 5
+esi 5
+esk 1
+eso 1
+esp 5
+ess 81
+est 53
+esu 2
+et  63
+et, 6
+et. 4
+eta 12
+etc 1
+ete 13
+eth 33
+eti 13
+etl 1
+ets 15
+ett 39
+etu 4
+etw 14
+ety 2
+eum 1
+eur 4
+eva 1
+eve 135
+evi 8
+ew  45
+ew, 1
+ewa 8
+ewc 2
+ewh 1
+ewo 1
+ews 7
+ex  1
+exa 8
+exc 10
+exe 1
+exh 1
+exi 4
+exp 14
+ext 15
+ey  104
+ey, 4
+ey. 3
+ey: 1
+eye 4
+eyo 1
+eyw 6
+f a 36
+f b 7
+f c 12
+f d 6
+f e 6
+f f 11
+f g 8
+f h 11
+f i 8
+f j 1
+f l 10
+f m 10
+f n 3
+f o 12
+f p 13
+f q 1
+f r 4
+f s 25
+f t 137
+f u 4
+f v 1
+f w 10
+f y 6
+f,  6
+f.  5
+fac 10
+fad 1
+fai 4
+fal 5
+fam 8
+far 17
+fas 12
+fe  8
+fe, 2
+fe. 4
+fea 3
+fec 4
+fed 1
+fee 15
+fel 5
+fem 1
+fen 1
+fer 22
+fes 3
+few 19
+ff  2
+ff, 1
+ffe 22
+ffi 10
+ffo 5
+ffs 2
+fib 1
+fic 11
+fie 6
+fif 4
+fig 1
+fil 7
+fin 24
+fir 31
+fis 9
+fit 2
+fiv 5
+fix 2
+fla 8
+fle 3
+fli 1
+flo 19
+flu 2
+fly 4
+fn. 1
+fol 11
+fon 1
+foo 13
+for 165
+fou 9
+fra 1
+fre 9
+fri 11
+fro 61
+fru 6
+fs  1
+fsi 2
+ft  12
+ft, 1
+ft. 2
+fte 50
+fts 1
+fty 2
+fue 3
+ful 29
+fur 3
+fus 3
+g a 47
+g b 12
+g c 11
+g d 7
+g e 11
+g f 11
+g g 2
+g h 9
+g i 27
+g j 1
+g l 5
+g m 8
+g n 3
+g o 19
+g p 8
+g r 4
+g s 24
+g t 78
+g u 7
+g v 1
+g w 22
+g y 1
+g,  25
+g.  22
+gai 16
+gal 2
+gam 9
+gan 8
+gar 11
+gas 5
+gat 5
+gav 2
+gdo 1
+ge  61
+ge, 7
+ge. 7
+ged 7
+gen 10
+geo 1
+ger 30
+ges 37
+get 21
+gge 4
+ggi 1
+ggl 1
+ggs 1
+gh  31
+gh, 5
+gh. 2
+ghb 1
+ghe 2
+ghi 1
+ghs 1
+ght 78
+gin 19
+gio 2
+giv 14
+gla 3
+gle 14
+gli 3
+glu 4
+gly 4
+gn  4
+gn, 1
+gna 4
+gne 1
+gni 1
+gns 5
+go  5
+go, 2
+go. 3
+goa 7
+god 1
+goe 1
+goi 2
+gol 2
+gon 1
+goo 14
+gor 1
+got 1
+gov 4
+gra 38
+gre 24
+gri 1
+gro 24
+gry 1
+gs  26
+gs, 4
+gs. 4
+gth 7
+gua 15
+gue 8
+gul 6
+gum 2
+gus 1
+gut 1
+gy  1
+gy, 1
+gy? 1
+gym 2
+gyp 1
+h a 42
+h b 7
+h c 19
+h d 5
+h e 13
+h f 15
+h g 1
+h h 9
+h i 25
+h k 2
+h l 11
+h m 16
+h n 4
+h o 25
+h p 9
+h r 3
+h s 17
+h t 32
+h u 2
+h v 1
+h w 6
+h,  18
+h.  15
+hab 17
+had 27
+hai 4
+hal 11
+ham 6
+han 87
+hap 14
+har 15
+has 37
+hat 189
+hau 1
+hav 49
+hbo 1
+hch 2
+he  996
+hea 48
+hec 5
+hed 14
+hee 7
+hei 47
+hel 22
+hem 32
+hen 69
+heo 2
+her 186
+hes 32
+het 5
+hey 76
+hi  1
+hic 19
+hid 2
+hie 1
+hif 7
+hig 4
+hil 38
+him 4
+hin 65
+hio 1
+hip 4
+hir 3
+his 41
+hit 2
+hiv 3
+hm. 1
+hmo 1
+ho  43
+hod 7
+hoe 2
+hoi 1
+hol 24
+hom 9
+hon 20
+hoo 18
+hop 9
+hor 12
+hos 10
+hot 17
+hou 51
+how 35
+hra 1
+hre 4
+hro 10
+hru 1
+hs  8
+hs, 4
+ht  49
+ht' 1
+ht, 6
+ht. 7
+hte 3
+hth 1
+hti 3
+htl 1
+hts 8
+hty 1
+hug 1
+hum 6
+hun 11
+hur 3
+hwo 1
+hy  10
+hy, 1
+hys 2
+hyt 1
+i a 1
+i c 1
+i e 2
+i f 2
+i h 7
+i l 2
+i m 1
+i p 2
+i s 2
+i t 3
+i w 3
+ia  5
+ia, 1
+ia. 1
+iab 3
+iag 1
+ial 5
+ian 3
+iat 1
+ibb 1
+ibe 6
+ibl 16
+ibr 11
+ic  12
+ic, 3
+ic. 1
+ica 13
+ice 33
+ich 22
+ici 7
+ick 18
+ics 6
+ict 5
+icu 7
+icy 6
+id  15
+id, 1
+ida 1
+idd 4
+ide 52
+idg 1
+idi 5
+idu 1
+iec 10
+ied 10
+ief 1
+iel 6
+ien 29
+ier 8
+ies 41
+iet 13
+iev 4
+iew 3
+if  20
+if, 1
+ife 9
+iff 20
+ifi 1
+ift 14
+ifu 1
+ig  1
+iga 1
+ige 5
+igg 1
+igh 80
+igi 1
+ign 15
+ike 14
+il  17
+il, 1
+il. 3
+ila 4
+ild 34
+ile 18
+ili 5
+ilk 1
+ill 58
+ilm 2
+ilo 5
+ils 6
+ilt 7
+ilv 2
+ily 7
+im  4
+im, 1
+ima 9
+imb 4
+ime 49
+imi 5
+imm 3
+imp 54
+ims 1
+in  257
+in, 6
+in. 5
+ina 16
+inc 12
+ind 48
+ine 44
+inf 5
+ing 338
+ini 3
+ink 14
+inl 3
+inn 6
+ins 42
+int 59
+inu 11
+inv 14
+iny 2
+io, 1
+iod 2
+iol 1
+ion 78
+ior 6
+iou 7
+iox 2
+ip  4
+ip, 2
+ipe 3
+iph 8
+ipl 1
+ipm 2
+ipp 2
+ips 2
+ipt 3
+ir  67
+ir, 1
+ir. 1
+irc 2
+ird 4
+ire 22
+iri 1
+iro 1
+irp 14
+irr 2
+irs 34
+irt 2
+is  240
+is, 2
+is. 1
+isa 4
+isc 9
+ise 25
+ish 23
+isi 9
+isk 4
+isl 1
+ism 1
+isp 1
+iss 3
+ist 53
+isy 1
+it  164
+it, 9
+it. 17
+ita 2
+itc 8
+ite 27
+ith 90
+iti 33
+itn 2
+ito 1
+its 25
+itt 18
+itu 1
+ity 15
+ius 1
+ive 77
+ivi 11
+ix  3
+ixe 1
+ixi 1
+ixt 2
+ize 6
+jar 1
+jec 3
+jet 1
+job 3
+joi 2
+jor 2
+jou 3
+joy 2
+jud 2
+jug 1
+jul 1
+jum 2
+jun 1
+jup 2
+jus 5
+k a 19
+k b 3
+k c 2
+k d 1
+k e 1
+k f 7
+k h 7
+k i 10
+k l 2
+k m 2
+k n 2
+k o 17
+k p 2
+k q 1
+k s 2
+k t 22
+k u 3
+k w 7
+k y 3
+k,  14
+k.  10
+kab 2
+kas 1
+kat 1
+kda 1
+ke  42
+ke. 2
+ked 28
+kee 18
+kel 3
+ken 6
+kep 4
+ker 11
+kes 24
+ket 12
+key 14
+kgr 1
+ki  1
+kic 1
+kil 2
+kin 37
+kit 7
+kly 7
+kma 1
+kne 8
+kni 3
+kno 23
+ks  35
+ks, 10
+ks. 3
+ksh 2
+ksm 2
+kwo 1
+ky  11
+l a 35
+l b 10
+l c 18
+l d 4
+l e 9
+l f 12
+l g 6
+l h 12
+l i 18
+l j 1
+l k 1
+l l 3
+l m 10
+l n 9
+l o 19
+l p 10
+l r 7
+l s 13
+l t 32
+l u 6
+l v 1
+l w 4
+l y 1
+l's 1
+l,  19
+l.  19
+l:  1
+la  2
+lab 3
+lac 24
+lad 3
+lag 9
+lai 5
+lak 1
+lam 1
+lan 65
+lap 1
+lar 32
+las 20
+lat 25
+lau 5
+lav 2
+law 3
+lax 2
+lay 31
+lco 2
+lcu 3
+ld  81
+ld, 6
+ld. 11
+lde 14
+ldi 10
+ldr 18
+lds 4
+le  171
+le' 1
+le, 11
+le. 13
+le: 1
+lea 55
+lec 9
+led 20
+lee 15
+lef 7
+leg 2
+lem 9
+len 12
+leo 1
+lep 6
+ler 10
+les 50
+let 35
+lev 1
+lex 3
+ley 4
+lf  5
+lf, 3
+lf. 3
+lia 2
+lib 10
+lic 3
+lid 1
+lie 12
+lif 11
+lig 22
+lik 14
+lil 1
+lim 12
+lin 16
+lio 5
+lis 14
+lit 16
+liu 1
+liv 19
+liz 1
+lk  9
+lk. 3
+lke 5
+lki 5
+lks 1
+lky 1
+ll  128
+ll' 1
+ll, 9
+ll. 7
+ll: 1
+lla 12
+lle 32
+lli 8
+llo 17
+lls 16
+llu 1
+llw 1
+lly 22
+lm  1
+lme 1
+lmo 10
+lms 2
+loa 9
+loc 6
+log 1
+loi 1
+lom 1
+lon 52
+loo 33
+lop 5
+lor 3
+los 16
+lot 7
+lou 7
+lov 4
+low 44
+loy 4
+lp  4
+lp. 2
+lph 9
+lps 9
+lre 3
+ls  31
+ls, 12
+ls. 1
+lse 7
+lsi 1
+lso 19
+lt  27
+lt, 5
+lt. 3
+lta 1
+lth 6
+lti 5
+lto 1
+lts 5
+ltu 1
+lty 1
+lua 1
+lud 5
+lue 9
+lug 1
+lum 2
+lun 8
+lus 1
+lut 6
+lux 1
+lve 13
+lwa 10
+ly  108
+ly, 23
+ly. 10
+lyi 1
+lyp 1
+lys 3
+lyz 1
+m a 12
+m b 3
+m c 7
+m d 4
+m f 5
+m g 1
+m h 8
+m i 9
+m k 3
+m l 1
+m m 4
+m o 11
+m p 2
+m r 2
+m s 7
+m t 38
+m u 2
+m w 10
+m's 1
+m,  20
+m.  8
+ma  1
+mac 9
+mad 15
+mag 1
+mah 1
+mai 6
+maj 2
+mak 25
+mal 45
+man 66
+mar 18
+mas 4
+mat 33
+may 6
+maz 1
+mb  2
+mb, 2
+mb. 1
+mbe 33
+mbi 2
+mbl 4
+mbo 3
+mbr 2
+mbs 1
+me  107
+me, 13
+me. 13
+me: 1
+me? 1
+mea 20
+med 14
+mee 3
+mel 4
+mem 17
+men 31
+meo 9
+mer 27
+mes 45
+met 27
+mew 2
+mfo 1
+mia 1
+mic 3
+mid 3
+mig 7
+mil 14
+min 24
+mir 1
+mis 10
+mit 6
+mix 2
+mma 2
+mme 11
+mmi 4
+mmo 11
+mmu 6
+mn  2
+mns 1
+mod 3
+moi 1
+mok 2
+mom 6
+mon 36
+moo 5
+mor 62
+mos 48
+mot 5
+mou 10
+mov 24
+mp  3
+mpa 4
+mpe 4
+mpi 2
+mpl 41
+mpo 23
+mpr 5
+mps 2
+mpt 3
+mpu 17
+ms  31
+ms, 5
+ms. 6
+mse 4
+muc 25
+mud 4
+mul 1
+mun 5
+mus 8
+mut 1
+my  9
+my. 1
+n 1 3
+n a 130
+n b 43
+n c 32
+n d 20
+n e 27
+n f 24
+n g 8
+n h 24
+n i 54
+n j 1
+n k 1
+n l 19
+n m 21
+n n 5
+n o 34
+n p 28
+n r 12
+n s 62
+n t 219
+n u 11
+n v 4
+n w 39
+n y 6
+n,  46
+n.  45
+na  2
+na, 1
+nag 2
+nak 1
+nal 19
+nam 4
+nar 6
+nat 7
+nce 52
+nch 5
+nci 6
+ncl 6
+nco 3
+ncr 8
+nct 1
+ncu 1
+ncy 1
+nd  506
+nd, 13
+nd. 12
+ndc 1
+nde 28
+ndf 2
+ndh 1
+ndi 12
+ndl 5
+ndm 3
+ndo 10
+ndp 1
+ndr 7
+nds 29
+ndw 1
+ndy 2
+ne  99
+ne, 9
+ne. 6
+nea 11
+nec 7
+ned 19
+nee 27
+nei 1
+nel 4
+nem 3
+nen 4
+ner 22
+nes 42
+net 11
+nev 12
+new 27
+nex 10
+ney 20
+nfl 1
+nfo 7
+nfu 3
+ng  314
+ng, 23
+ng. 20
+ngd 1
+nge 52
+ngi 9
+ngl 18
+ngr 3
+ngs 28
+ngt 7
+ngu 14
+nic 5
+nie 1
+nif 1
+nig 20
+nim 3
+nin 61
+nio 1
+nis 2
+nit 6
+niv 1
+niz 1
+njo 2
+nk  14
+nk, 1
+nk. 1
+nke 2
+nki 2
+nkn 2
+nks 9
+nla 2
+nle 2
+nli 2
+nlo 1
+nly 29
+nme 4
+nna 1
+nne 11
+nni 10
+nno 3
+nny 1
+no  12
+nob 7
+noi 3
+nom 1
+non 1
+noo 8
+nor 6
+not 75
+nou 8
+nov 2
+now 33
+npa 1
+nri 1
+ns  62
+ns, 13
+ns. 14
+nse 5
+nsf 1
+nsh 1
+nsi 13
+nst 17
+nsu 3
+nsw 3
+nt  71
+nt' 2
+nt, 13
+nt. 9
+nta 12
+nte 31
+nth 13
+nti 30
+ntl 5
+nto 41
+ntr 19
+nts 42
+ntu 12
+nty 5
+nu  1
+nue 2
+num 17
+nus 1
+nut 12
+nve 16
+nvi 2
+nwa 2
+ny  57
+ny. 1
+nyo 7
+nyt 1
+nyw 2
+o a 37
+o b 20
+o c 39
+o d 15
+o e 10
+o f 16
+o g 13
+o h 22
+o i 8
+o j 3
+o k 11
+o l 26
+o m 22
+o n 11
+o o 6
+o p 22
+o q 2
+o r 33
+o s 49
+o t 93
+o u 10
+o v 2
+o w 21
+o y 3
+o'c 3
+o,  5
+o.  6
+oad 7
+oaf 6
+oal 8
+oar 3
+oas 3
+oat 3
+oav 1
+ob  2
+oba 2
+obj 3
+obl 8
+obo 7
+obs 2
+obt 1
+oca 2
+oce 6
+oci 1
+ock 13
+oct 1
+od  26
+od, 4
+od. 4
+oda 12
+ode 5
+ods 8
+odu 7
+ody 11
+oe. 1
+oen 1
+oes 16
+of  311
+off 14
+ofs 1
+oft 28
+og  1
+og. 1
+oge 7
+ogl 1
+ogn 1
+ogr 21
+ogs 2
+oic 5
+oid 3
+oil 11
+oin 15
+ois 4
+oit 1
+ok  15
+oke 15
+oki 5
+oks 17
+ol  20
+ol, 3
+ol. 2
+ola 1
+old 42
+ole 20
+oli 5
+oll 15
+olo 4
+ols 5
+olu 8
+olv 3
+om  66
+om, 7
+om. 1
+oma 19
+omb 3
+ome 103
+omf 1
+omi 2
+omm 18
+omp 30
+oms 4
+on  134
+on, 8
+on. 15
+ona 2
+onc 15
+ond 12
+one 126
+onf 3
+ong 61
+oni 2
+onl 28
+onn 4
+ono 1
+ons 42
+ont 24
+onv 3
+ony 2
+oo  9
+ood 32
+oof 3
+ook 43
+ool 24
+oom 14
+oon 19
+oop 4
+oor 3
+oos 6
+oot 13
+op  12
+op, 4
+op. 3
+ope 27
+opi 4
+opl 59
+opo 1
+opp 9
+ops 5
+opu 3
+or  165
+or, 3
+or. 4
+ora 4
+orc 3
+ord 35
+ore 81
+org 9
+ori 11
+ork 44
+orl 14
+orm 20
+orn 9
+orr 3
+ors 20
+ort 57
+orw 4
+ory 23
+osc 1
+ose 22
+osi 11
+osp 4
+oss 26
+ost 53
+ot  64
+ot, 1
+ot. 1
+ot? 1
+ota 3
+otb 4
+otc 1
+ote 20
+oth 57
+oti 5
+oto 10
+ots 11
+ott 5
+ou  25
+ou, 1
+ouc 1
+oud 3
+oug 34
+oul 47
+oun 75
+oup 4
+our 58
+ous 32
+out 71
+ova 1
+ove 77
+ovi 4
+ow  69
+ow, 3
+ow. 4
+ow? 1
+owa 3
+owd 2
+owe 27
+owi 5
+owl 7
+own 59
+ows 24
+ox  2
+oxe 2
+oxi 2
+oxy 2
+oy  2
+oy, 1
+oy. 1
+oya 1
+oye 3
+oym 1
+p a 11
+p b 1
+p d 2
+p e 2
+p f 1
+p g 1
+p h 1
+p i 8
+p m 2
+p o 8
+p p 1
+p q 1
+p r 1
+p s 3
+p t 18
+p u 1
+p w 4
+p,  10
+p.  10
+pac 10
+pag 2
+pai 10
+pal 2
+pan 3
+pap 10
+par 33
+pas 17
+pat 14
+pau 1
+pav 1
+paw 1
+pay 4
+pda 2
+pe  11
+pe, 2
+pe. 3
+pea 19
+pec 8
+ped 15
+pee 1
+pen 35
+peo 57
+per 43
+pes 1
+pet 3
+ph  5
+ph, 1
+ph. 1
+pha 9
+phe 11
+phi 1
+pho 24
+phr 1
+phs 5
+phy 3
+pic 7
+pid 1
+pie 13
+pig 1
+pil 7
+pin 11
+pio 2
+pit 4
+pla 88
+ple 93
+pli 4
+plo 4
+plu 1
+ply 11
+pme 2
+poc 3
+poi 3
+pok 2
+pol 9
+pon 6
+poo 1
+pop 3
+por 25
+pos 22
+pot 9
+pow 6
+ppe 21
+ppi 2
+ppl 4
+ppo 7
+ppy 1
+pra 2
+pre 23
+pri 22
+pro 51
+ps  19
+ps, 4
+psi 1
+pt  10
+pt, 2
+pta 1
+pte 5
+pth 1
+pti 6
+pto 2
+pts 1
+pty 3
+pub 3
+pul 5
+pum 3
+pun 1
+pup 2
+pur 2
+pus 10
+put 23
+pwa 1
+py  1
+pyr 1
+qua 7
+que 10
+qui 22
+r a 101
+r b 22
+r c 31
+r d 11
+r e 16
+r f 27
+r g 11
+r h 24
+r i 37
+r j 2
+r k 2
+r l 20
+r m 30
+r n 13
+r o 41
+r p 20
+r r 11
+r s 45
+r t 107
+r u 3
+r v 4
+r w 39
+r y 2
+r's 1
+r,  53
+r.  54
+r:  1
+ra  3
+ra, 1
+rab 4
+rac 6
+rad 12
+rag 9
+rah 1
+rai 35
+ral 15
+ram 16
+ran 29
+rap 16
+rar 13
+ras 6
+rat 29
+rav 8
+raw 3
+ray 2
+rba 1
+rbo 5
+rbs 2
+rce 5
+rch 11
+rci 1
+rcl 1
+rcu 1
+rd  37
+rd, 4
+rd. 9
+rd: 1
+rda 2
+rde 13
+rdi 7
+rdl 1
+rds 20
+rdy 1
+re  242
+re, 8
+re. 8
+rea 97
+rec 26
+red 49
+ree 30
+ref 15
+reg 6
+reh 1
+rei 1
+rel 17
+rem 16
+ren 43
+rep 19
+req 6
+res 45
+ret 17
+rev 4
+rew 13
+rey 2
+rfa 4
+rfe 2
+rfl 1
+rfo 1
+rfu 5
+rga 4
+rge 19
+rgi 1
+rgo 1
+rgu 4
+rgy 3
+rha 1
+rhy 1
+ria 7
+rib 6
+ric 16
+rid 4
+rie 40
+rig 15
+ril 2
+rim 3
+rin 35
+rio 6
+rip 10
+ris 24
+rit 29
+riv 21
+rk  27
+rk, 5
+rk. 2
+rka 2
+rke 18
+rki 5
+rkn 1
+rks 8
+rld 14
+rli 1
+rly 15
+rm  12
+rm, 2
+rm. 1
+rma 6
+rme 6
+rmi 4
+rmo 1
+rms 11
+rmy 3
+rn  22
+rn, 3
+rn. 1
+rna 1
+rne 14
+rni 21
+rnm 4
+rno 8
+rns 6
+roa 5
+rob 10
+roc 9
+rod 7
+roe 2
+rog 11
+rok 4
+rol 4
+rom 59
+ron 21
+roo 21
+rop 11
+ror 3
+ros 11
+rot 16
+rou 35
+rov 5
+row 26
+roy 1
+rpl 14
+rpo 1
+rpr 5
+rra 2
+rre 4
+rri 12
+rro 5
+rry 8
+rs  133
+rs, 33
+rs. 21
+rs: 1
+rsa 3
+rse 4
+rsh 1
+rsi 1
+rsl 1
+rso 6
+rst 41
+rt  39
+rt, 2
+rt. 2
+rta 24
+rtb 1
+rte 12
+rth 19
+rti 5
+rtl 3
+rtm 4
+rts 4
+rtu 3
+rty 6
+ruc 4
+rue 3
+rug 1
+rui 6
+rul 15
+run 7
+rus 12
+rve 12
+rvi 7
+rvo 1
+rwa 5
+rwi 1
+ry  103
+ry, 14
+ry. 6
+ryi 5
+ryo 10
+ryp 8
+rys 1
+ryt 5
+s a 248
+s b 47
+s c 58
+s d 30
+s e 22
+s f 44
+s g 16
+s h 40
+s i 93
+s j 5
+s k 8
+s l 31
+s m 35
+s n 48
+s o 133
+s p 36
+s q 2
+s r 24
+s s 94
+s t 214
+s u 25
+s v 8
+s w 101
+s y 3
+s,  170
+s.  122
+s:  5
+sac 1
+sad 3
+saf 6
+sag 19
+sai 7
+sal 23
+sam 23
+san 18
+sap 3
+sar 3
+sat 10
+sau 1
+sav 3
+say 8
+sca 2
+sce 2
+sch 13
+sci 5
+scl 2
+sco 8
+scr 17
+scu 4
+sda 1
+se  91
+se, 8
+se. 10
+sea 23
+sec 17
+sed 28
+see 36
+sef 4
+sel 15
+sem 1
+sen 18
+sep 1
+ser 16
+ses 28
+set 7
+seu 1
+sev 15
+sfo 1
+sfu 4
+sh  16
+sh, 3
+sh. 2
+sha 11
+shc 2
+she 47
+shi 18
+shm 1
+sho 38
+sia 1
+sib 15
+sic 8
+sid 23
+sie 5
+sig 17
+sil 3
+sim 30
+sin 36
+sio 5
+sis 8
+sit 19
+siv 4
+six 3
+siz 4
+sk  8
+sk, 1
+sk. 2
+ske 2
+ski 4
+sks 1
+sky 10
+sle 18
+slo 14
+sly 1
+sm. 1
+sma 41
+sme 3
+smi 3
+smo 5
+sno 2
+so  51
+soc 1
+sof 3
+soi 9
+sol 8
+som 51
+son 14
+soo 8
+sop 1
+sor 1
+sou 20
+sp. 1
+spa 11
+spe 22
+sph 3
+spi 3
+spl 2
+spo 6
+spr 12
+squ 5
+sro 1
+ss  48
+ss, 7
+ss. 8
+ssa 21
+sse 16
+ssf 4
+ssi 20
+ssl 1
+sso 2
+ssr 1
+ssu 1
+ssw 5
+st  146
+st, 11
+st. 9
+sta 78
+ste 50
+sti 35
+stl 1
+sto 54
+str 42
+sts 26
+stu 17
+sty 2
+sua 6
+suc 12
+sud 3
+sue 1
+suf 1
+sug 5
+sui 2
+sul 2
+sum 9
+sun 13
+sup 3
+sur 20
+sus 2
+swa 2
+swe 6
+swi 5
+swo 5
+sy  6
+sy, 1
+sy. 1
+sym 3
+sys 9
+t a 100
+t b 30
+t c 50
+t d 30
+t e 32
+t f 40
+t g 16
+t h 34
+t i 140
+t j 1
+t k 4
+t l 27
+t m 28
+t n 17
+t o 76
+t p 29
+t q 2
+t r 29
+t s 79
+t t 189
+t u 11
+t v 1
+t w 68
+t y 12
+t's 3
+t,  65
+t.  60
+t?  2
+ta  3
+ta, 1
+ta. 2
+tab 18
+tac 7
+tag 7
+tai 18
+tak 29
+tal 23
+tam 2
+tan 41
+tap 2
+tar 28
+tas 6
+tat 9
+tau 2
+tax 4
+tay 6
+tba 4
+tbe 1
+tch 25
+te  43
+te, 6
+te. 5
+tea 26
+teb 2
+tec 11
+ted 46
+tee 13
+teg 1
+tei 1
+tel 24
+tem 14
+ten 59
+tep 7
+ter 148
+tes 24
+tet 1
+tev 4
+tew 1
+tex 3
+tfo 1
+th  119
+th, 5
+th. 7
+tha 205
+the 1253
+thi 62
+thm 1
+tho 44
+thr 15
+ths 6
+thu 1
+thy 1
+tia 1
+tic 20
+tie 21
+tif 1
+tig 1
+til 24
+tim 45
+tin 55
+tio 63
+tip 1
+tir 8
+tis 10
+tit 3
+tiv 12
+tle 14
+tly 15
+tma 1
+tme 4
+tmo 3
+tne 2
+to  338
+tod 12
+toe 2
+tog 18
+tok 1
+tol 3
+tom 18
+ton 4
+too 18
+top 10
+tor 51
+tou 5
+tov 1
+tow 20
+tpu 1
+tra 51
+tre 20
+tri 17
+tro 14
+tru 10
+try 13
+ts  126
+ts, 14
+ts. 14
+ts: 1
+tse 4
+tsi 5
+tta 8
+tte 53
+tti 8
+ttl 12
+tto 2
+ttu 1
+tub 2
+tuc 2
+tud 13
+tue 1
+tum 1
+tun 4
+tur 47
+twe 16
+twi 3
+two 27
+ty  29
+ty, 3
+ty. 4
+ty? 1
+typ 2
+u a 4
+u c 5
+u d 2
+u f 3
+u h 2
+u i 1
+u k 1
+u l 1
+u n 1
+u p 1
+u r 1
+u s 1
+u t 3
+u,  1
+uab 1
+uag 13
+ual 8
+uar 7
+uat 1
+ube 2
+ubl 3
+uca 1
+ucc 5
+uce 10
+uch 32
+uck 2
+uct 6
+ud  2
+ud, 2
+udd 4
+ude 7
+udg 2
+udi 6
+uds 1
+udy 6
+ue  8
+ue, 2
+ue. 2
+ued 1
+uee 3
+uel 3
+uen 3
+ues 14
+uet 1
+uff 1
+ug  1
+uga 2
+uge 1
+ugg 5
+ugh 41
+ugl 1
+ugu 1
+uic 7
+uie 7
+uil 17
+uin 1
+uip 3
+uir 5
+uit 10
+ul  19
+ul, 2
+ul. 3
+ula 12
+uld 45
+ule 15
+uli 1
+ull 9
+uls 1
+ult 18
+um  1
+uma 6
+umb 20
+ume 3
+umm 8
+umn 3
+ump 4
+ums 1
+un  8
+un, 2
+un. 1
+una 2
+unc 9
+und 57
+une 4
+unf 3
+ung 20
+uni 9
+unk 1
+unl 2
+unn 6
+unp 1
+unr 1
+uns 2
+unt 31
+uoy 1
+up  16
+up. 2
+upd 2
+upe 1
+uph 1
+upi 4
+upl 1
+upp 3
+ups 1
+upw 1
+ur  32
+ur, 3
+ur. 1
+ura 7
+urc 5
+urd 3
+ure 14
+urf 4
+uri 19
+urk 1
+urn 29
+uro 4
+urp 5
+urr 3
+urs 11
+urt 4
+urv 7
+ury 9
+us  26
+us, 3
+us. 2
+usa 13
+usc 2
+use 84
+ush 15
+usi 13
+usp 2
+uss 2
+ust 25
+usu 6
+usy 2
+ut  107
+ut, 4
+ut. 3
+ute 33
+uth 1
+uti 2
+uto 5
+utp 1
+utr 2
+uts 8
+utt 4
+utu 1
+uum 1
+uxu 1
+uy  2
+uyi 1
+vab 1
+vac 1
+vai 1
+val 9
+van 7
+vap 2
+ve  110
+ve, 4
+ve. 3
+vea 1
+ved 13
+veg 6
+vel 14
+vem 4
+ven 49
+ver 168
+ves 52
+vey 2
+vib 1
+vic 7
+vid 8
+vie 3
+vig 1
+vil 10
+vin 11
+vio 7
+vis 3
+vit 3
+viv 3
+voc 1
+voi 7
+vol 4
+vor 2
+vou 1
+vow 1
+vy  3
+w a 11
+w b 10
+w c 7
+w d 6
+w e 2
+w f 5
+w h 6
+w i 7
+w l 3
+w m 9
+w o 7
+w p 5
+w q 1
+w r 2
+w s 7
+w t 16
+w u 1
+w v 3
+w w 5
+w y 3
+w,  4
+w.  4
+w?  1
+wag 1
+wai 7
+wak 1
+wal 16
+wan 8
+war 30
+was 53
+wat 33
+wav 1
+wax 2
+way 45
+wco 2
+wde 2
+wdf 1
+we  37
+wea 20
+web 4
+wed 5
+wee 20
+wei 5
+wel 10
+wen 9
+wep 1
+wer 56
+wes 1
+wet 4
+wev 4
+wha 28
+whe 70
+whi 31
+who 58
+why 8
+wic 4
+wid 2
+wil 13
+wim 3
+win 34
+wir 4
+wis 4
+wit 91
+wle 1
+wls 1
+wly 5
+wn  34
+wn, 7
+wn. 9
+wne 4
+wni 4
+wns 4
+wnw 2
+wo  22
+wo, 1
+wom 8
+won 2
+wor 93
+wou 15
+wri 24
+wro 8
+ws  25
+ws, 2
+ws. 1
+wsp 4
+wwd 1
+x b 1
+x c 1
+x h 1
+x i 1
+x o 2
+x s 1
+x w 1
+x.  1
+xac 4
+xam 3
+xan 1
+xce 3
+xch 5
+xci 2
+xe  1
+xed 2
+xer 1
+xes 4
+xha 1
+xib 1
+xid 2
+xin 2
+xis 3
+xpa 1
+xpe 10
+xpl 3
+xt  11
+xt. 1
+xte 1
+xti 1
+xtr 3
+xur 1
+xy. 1
+xyg 2
+y a 72
+y b 29
+y c 36
+y d 30
+y e 12
+y f 15
+y g 6
+y h 31
+y i 44
+y j 2
+y k 9
+y l 18
+y m 20
+y n 11
+y o 48
+y p 23
+y q 2
+y r 13
+y s 38
+y t 90
+y u 6
+y v 1
+y w 35
+y y 9
+y,  68
+y.  39
+y:  2
+y?  3
+ya  1
+yal 1
+yar 1
+ycl 9
+ye  3
+yea 31
+yed 7
+yee 1
+yel 4
+yer 14
+yes 1
+yet 13
+yge 2
+yin 9
+ym  1
+ym, 1
+ymb 3
+yme 1
+yon 18
+you 52
+ype 2
+yph 1
+ypt 9
+yru 1
+ys  26
+ys, 3
+ys. 4
+ys: 1
+ysi 5
+yst 10
+yth 7
+ywh 2
+ywo 6
+yze 1
+ze  4
+ze. 1
+zed 2
+zes 1
+`
+
+// englishQuadgrams lists the count of each 4-gram seen in the reference English text.
+const englishQuadgrams = `
+ a b 23
+ a c 30
+ a d 20
+ a f 37
+ a g 19
+ a h 11
+ a k 5
+ a l 40
+ a m 27
+ a n 18
+ a p 26
+ a q 3
+ a r 21
+ a s 82
+ a t 26
+ a v 6
+ a w 29
+ a y 9
+ abo 37
+ abs 2
+ acc 11
+ acr 8
+ act 4
+ add 4
+ adu 7
+ adv 6
+ aff 2
+ aft 19
+ aga 16
+ age 5
+ ago 5
+ agr 2
+ ahe 7
+ air 29
+ all 24
+ alm 10
+ alo 12
+ alp 9
+ alr 3
+ als 19
+ alt 3
+ alw 9
+ ame 2
+ amo 5
+ an  32
+ ana 3
+ anc 2
+ and 399
+ ang 2
+ ani 2
+ ann 2
+ ano 7
+ ans 3
+ any 19
+ apa 4
+ app 10
+ are 75
+ arg 4
+ arm 4
+ aro 11
+ arr 8
+ art 2
+ as  54
+ ask 7
+ at  58
+ atm 3
+ att 10
+ aut 6
+ ave 2
+ avo 3
+ awa 11
+ awn 3
+ bac 11
+ bad 4
+ bag 4
+ bak 7
+ bal 6
+ ban 13
+ bas 6
+ be  43
+ bea 9
+ bec 43
+ bed 3
+ bee 28
+ bef 19
+ beg 9
+ beh 12
+ bel 9
+ ben 2
+ bes 6
+ bet 15
+ bic 6
+ big 2
+ bil 3
+ bir 3
+ bis 3
+ bla 3
+ blo 7
+ blu 2
+ boa 6
+ bod 4
+ bon 2
+ boo 15
+ bot 9
+ box 4
+ bra 10
+ bre 16
+ bri 11
+ bro 6
+ bui 17
+ bur 4
+ bus 10
+ but 42
+ buy 3
+ by  54
+ cab 4
+ caf 3
+ cak 2
+ cal 19
+ cam 10
+ can 64
+ car 34
+ cat 3
+ cau 6
+ cen 18
+ cer 3
+ cha 32
+ che 17
+ chi 24
+ cho 11
+ chu 3
+ cip 6
+ cir 2
+ cit 12
+ cla 7
+ cle 9
+ cli 11
+ clo 16
+ clu 2
+ coa 4
+ cod 2
+ cof 3
+ coi 5
+ col 17
+ com 66
+ con 25
+ coo 12
+ cop 3
+ cos 3
+ cou 47
+ cov 6
+ cra 2
+ cre 8
+ cri 4
+ cro 5
+ cru 2
+ cry 2
+ cur 6
+ cus 8
+ cut 7
+ cyc 3
+ dan 6
+ dar 9
+ dat 6
+ day 42
+ dea 6
+ dec 8
+ dee 6
+ def 4
+ del 3
+ dep 6
+ des 16
+ det 8
+ dev 7
+ dia 2
+ did 3
+ die 3
+ dif 20
+ din 3
+ dio 2
+ dir 5
+ dis 28
+ div 2
+ do  13
+ do. 3
+ doe 11
+ dog 3
+ doi 3
+ doo 2
+ dou 9
+ dow 17
+ dra 9
+ dre 5
+ dri 8
+ dro 7
+ dur 8
+ eac 26
+ ear 15
+ eas 11
+ eat 7
+ eff 5
+ eig 4
+ ele 4
+ els 7
+ emp 5
+ enc 7
+ end 14
+ ene 4
+ eng 8
+ enj 2
+ eno 8
+ ent 4
+ equ 5
+ err 2
+ eur 4
+ eve 89
+ evi 3
+ exa 7
+ exc 10
+ exi 3
+ exp 13
+ ext 3
+ eye 4
+ fac 6
+ fai 4
+ fal 5
+ fam 8
+ far 17
+ fas 12
+ fee 12
+ fel 4
+ few 19
+ fie 6
+ fif 4
+ fil 7
+ fin 24
+ fir 31
+ fis 9
+ fit 2
+ fiv 5
+ fix 2
+ fla 8
+ flo 18
+ fly 4
+ fol 11
+ foo 13
+ for 130
+ fou 9
+ fre 9
+ fri 9
+ fro 61
+ fru 6
+ fue 3
+ ful 5
+ fur 3
+ gal 2
+ gam 9
+ gar 9
+ gas 5
+ gat 4
+ gav 2
+ gen 6
+ get 6
+ giv 13
+ gla 3
+ glu 4
+ go  5
+ goa 7
+ goi 2
+ gol 2
+ goo 14
+ gov 4
+ gra 16
+ gre 19
+ gro 23
+ gua 2
+ gue 4
+ gym 2
+ hab 8
+ had 27
+ hai 2
+ hal 9
+ han 11
+ hap 5
+ har 11
+ has 37
+ hav 43
+ he  22
+ hea 39
+ hel 17
+ her 26
+ hid 2
+ hig 4
+ hil 3
+ him 4
+ his 20
+ hiv 3
+ hol 9
+ hom 6
+ hon 5
+ hot 7
+ hou 15
+ how 24
+ hum 6
+ hun 10
+ i e 2
+ i f 2
+ i h 7
+ i s 2
+ i t 3
+ i w 3
+ ice 5
+ ide 10
+ if  20
+ ill 2
+ imp 26
+ in  210
+ inc 8
+ ind 6
+ inf 5
+ ing 2
+ ins 21
+ int 41
+ inv 14
+ is  211
+ it  142
+ it, 6
+ it. 15
+ its 15
+ job 3
+ joi 2
+ jou 3
+ jud 2
+ jum 2
+ jup 2
+ jus 5
+ kee 16
+ kep 4
+ key 13
+ kin 8
+ kit 7
+ kne 4
+ kni 3
+ kno 21
+ lab 2
+ lai 2
+ lan 22
+ lar 16
+ las 10
+ lat 13
+ lau 5
+ law 3
+ lay 2
+ lea 39
+ lef 7
+ len 6
+ les 9
+ let 30
+ lib 10
+ lie 3
+ lif 11
+ lig 19
+ lik 14
+ lim 2
+ lin 4
+ lis 6
+ lit 11
+ liv 17
+ loa 8
+ lon 35
+ loo 23
+ los 7
+ lov 4
+ low 4
+ lun 4
+ mac 9
+ mad 15
+ mai 5
+ maj 2
+ mak 25
+ man 49
+ mar 13
+ mat 12
+ may 6
+ me  3
+ mea 20
+ med 6
+ mee 3
+ mem 7
+ men 2
+ mes 20
+ met 12
+ mid 3
+ mig 7
+ mil 4
+ min 13
+ mis 9
+ mix 2
+ mod 3
+ mom 6
+ mon 21
+ moo 2
+ mor 57
+ mos 35
+ mot 2
+ mou 4
+ mov 24
+ muc 25
+ mud 4
+ mus 8
+ my  5
+ nam 3
+ nar 2
+ nat 2
+ nea 7
+ nec 2
+ nee 24
+ net 4
+ nev 11
+ new 25
+ nex 9
+ nig 17
+ nin 4
+ no  12
+ nob 7
+ noi 3
+ nor 5
+ not 65
+ nov 2
+ now 9
+ num 17
+ nut 3
+ o'c 3
+ obj 3
+ oce 2
+ of  309
+ off 11
+ oft 25
+ oil 2
+ old 17
+ on  71
+ onc 12
+ one 49
+ onl 26
+ ope 14
+ opp 6
+ or  49
+ ord 5
+ org 4
+ oth 31
+ our 13
+ out 23
+ ove 25
+ own 14
+ oxy 2
+ pac 2
+ pag 2
+ pai 7
+ pal 2
+ pap 6
+ par 22
+ pas 16
+ pat 13
+ pay 4
+ pen 2
+ peo 57
+ per 14
+ pho 18
+ phy 2
+ pic 6
+ pie 10
+ pil 5
+ pla 66
+ ple 3
+ poc 3
+ poi 3
+ pol 9
+ pop 3
+ pos 15
+ pot 6
+ pow 6
+ pre 14
+ pri 12
+ pro 47
+ pub 3
+ pul 2
+ pum 3
+ pup 2
+ pur 2
+ pus 10
+ put 5
+ que 9
+ qui 14
+ rad 2
+ rai 11
+ ran 6
+ rar 3
+ rat 8
+ rea 43
+ rec 14
+ red 6
+ ref 6
+ reg 6
+ rel 11
+ rem 14
+ ren 2
+ rep 14
+ req 5
+ res 16
+ ret 7
+ rev 2
+ rew 8
+ ric 3
+ rid 3
+ rig 6
+ rip 2
+ ris 15
+ riv 12
+ roa 4
+ roc 5
+ rom 2
+ roo 19
+ rot 2
+ rou 2
+ row 2
+ rul 15
+ run 6
+ rus 5
+ sad 2
+ saf 6
+ sai 7
+ sal 23
+ sam 23
+ san 4
+ sat 7
+ sav 3
+ say 8
+ sch 13
+ sci 5
+ scr 13
+ sea 16
+ sec 16
+ see 36
+ sel 4
+ sen 13
+ ser 7
+ set 6
+ sev 15
+ sha 11
+ she 34
+ shi 9
+ sho 34
+ sid 8
+ sig 12
+ sil 2
+ sim 30
+ sin 14
+ sit 6
+ six 3
+ siz 4
+ sky 10
+ sle 15
+ slo 14
+ sma 41
+ sme 3
+ smo 5
+ sno 2
+ so  32
+ sof 3
+ soi 9
+ sol 6
+ som 51
+ soo 8
+ sou 20
+ spa 7
+ spe 18
+ spl 2
+ spo 5
+ spr 12
+ squ 5
+ sta 54
+ ste 11
+ sti 21
+ sto 31
+ str 36
+ stu 15
+ suc 12
+ sud 3
+ sug 5
+ sui 2
+ sum 8
+ sun 13
+ sup 3
+ sur 15
+ sus 2
+ swa 2
+ swe 3
+ swi 5
+ sym 3
+ sys 9
+ tab 8
+ tak 23
+ tal 15
+ tap 2
+ tas 6
+ tax 4
+ tea 16
+ tee 2
+ tel 16
+ tem 3
+ ten 10
+ tes 2
+ tex 2
+ tha 205
+ the 1164
+ thi 43
+ tho 22
+ thr 15
+ tic 2
+ tim 38
+ tin 2
+ tir 3
+ to  300
+ tod 12
+ tog 6
+ tol 3
+ tom 3
+ too 17
+ top 2
+ tou 5
+ tow 20
+ tra 35
+ tre 3
+ tri 6
+ tru 5
+ try 7
+ tub 2
+ tur 17
+ twe 6
+ twi 3
+ two 23
+ typ 2
+ umb 2
+ unc 3
+ und 16
+ une 3
+ unf 3
+ uni 2
+ unt 3
+ up  13
+ up. 2
+ upd 2
+ us  11
+ us, 3
+ use 47
+ usu 6
+ val 9
+ veg 6
+ ver 13
+ vil 9
+ vis 3
+ voi 4
+ vol 4
+ wai 7
+ wal 15
+ wan 8
+ war 12
+ was 53
+ wat 33
+ wax 2
+ way 23
+ we  37
+ wea 18
+ web 4
+ wee 10
+ wei 5
+ wel 8
+ wen 3
+ wer 32
+ wet 4
+ wha 28
+ whe 67
+ whi 31
+ who 58
+ why 8
+ wid 2
+ wil 13
+ win 29
+ wir 4
+ wis 3
+ wit 89
+ wom 8
+ won 2
+ wor 75
+ wou 15
+ wri 24
+ wro 8
+ yea 31
+ yel 4
+ yet 13
+ you 52
+'clo 3
+'s g 2
+, a  25
+, ac 2
+, al 10
+, an 139
+, ar 2
+, as 3
+, at 3
+, ba 3
+, be 21
+, bu 37
+, ca 7
+, ch 7
+, co 3
+, cr 2
+, de 2
+, ea 2
+, ev 9
+, fa 4
+, fi 4
+, fo 8
+, fr 4
+, he 5
+, ho 5
+, i  3
+, in 9
+, it 16
+, ju 2
+, la 3
+, le 5
+, lo 4
+, ma 12
+, me 3
+, mo 4
+, no 4
+, of 5
+, or 8
+, pa 2
+, pe 4
+, pl 3
+, pr 3
+, pu 4
+, qu 2
+, re 3
+, ru 2
+, sa 6
+, se 6
+, sh 5
+, si 3
+, sm 2
+, so 18
+, sp 3
+, st 3
+, su 4
+, ta 3
+, th 99
+, to 10
+, tw 3
+, un 3
+, wa 8
+, we 2
+, wh 39
+, wi 5
+, wr 2
+, ye 5
+. a  37
+. ab 2
+. al 2
+. an 9
+. as 5
+. at 7
+. be 6
+. bo 2
+. bu 6
+. by 6
+. ca 2
+. ch 2
+. co 2
+. de 2
+. du 2
+. ea 8
+. en 2
+. ev 4
+. fi 6
+. fo 16
+. go 5
+. he 7
+. hi 2
+. ho 2
+. i  6
+. if 8
+. in 34
+. it 25
+. ke 2
+. la 2
+. le 3
+. li 9
+. ma 12
+. mo 11
+. my 2
+. ne 3
+. no 2
+. on 18
+. ot 3
+. ou 2
+. ov 5
+. pa 2
+. pe 5
+. pl 6
+. pr 3
+. re 5
+. sa 2
+. sc 3
+. se 2
+. sh 7
+. so 13
+. st 6
+. th 140
+. to 9
+. tr 2
+. tw 2
+. un 4
+. ve 2
+. wa 4
+. we 9
+. wh 21
+. wi 6
+. wo 2
+. ye 9
+. yo 2
+: a  3
+: th 4
+? wh 3
+a an 4
+a ba 6
+a be 2
+a bi 6
+a bl 3
+a bo 3
+a br 2
+a ce 3
+a ci 2
+a cl 2
+a co 20
+a cr 4
+a da 5
+a de 7
+a di 7
+a dr 2
+a fa 4
+a fe 14
+a fi 7
+a fl 3
+a fo 6
+a fr 4
+a ga 4
+a go 9
+a gr 8
+a ha 5
+a he 3
+a ho 2
+a hu 2
+a in 2
+a is 4
+a ke 2
+a ki 3
+a la 9
+a le 2
+a li 10
+a lo 18
+a ma 9
+a me 9
+a mi 2
+a mo 3
+a mu 4
+a na 2
+a ne 11
+a no 3
+a nu 2
+a of 2
+a pa 6
+a pe 2
+a ph 2
+a pi 4
+a pl 5
+a po 2
+a pr 4
+a qu 3
+a re 13
+a ri 3
+a ro 5
+a sa 3
+a sc 5
+a se 5
+a sh 5
+a si 18
+a sm 23
+a so 6
+a st 13
+a su 4
+a te 5
+a th 4
+a ti 5
+a to 6
+a tr 5
+a ve 2
+a vo 4
+a wa 12
+a we 7
+a wh 7
+a wi 4
+a wo 3
+a ye 4
+a yo 5
+a, s 2
+a. t 2
+abet 10
+abit 8
+able 32
+ably 3
+abou 32
+abov 5
+abso 2
+acce 5
+acci 2
+acco 3
+ace  15
+ace, 2
+ace. 3
+aces 11
+ach  26
+ach, 2
+ache 15
+achi 9
+ack  18
+ack, 2
+acke 4
+acks 5
+acro 8
+acti 5
+actl 4
+acto 2
+acts 4
+ad a 10
+ad b 4
+ad c 3
+ad f 2
+ad i 7
+ad m 2
+ad n 5
+ad o 9
+ad r 2
+ad s 3
+ad t 12
+ad w 5
+ad,  9
+ad.  9
+adde 2
+ade  16
+ade. 3
+aded 3
+ader 5
+adin 5
+adly 2
+ads  7
+adul 7
+adva 6
+ady  5
+afe  3
+afe. 2
+afes 3
+affe 2
+afte 19
+ag,  2
+agai 16
+age  34
+age, 5
+age. 2
+ager 2
+ages 22
+agin 2
+ago, 2
+ago. 3
+agre 2
+ague 2
+ahea 7
+aid  10
+aigh 2
+ail  3
+ails 3
+ain  38
+ain, 4
+ain. 5
+aine 5
+ainl 2
+ains 13
+aint 2
+air  20
+airp 14
+airs 3
+ait. 2
+aiti 5
+ajor 2
+ak t 3
+ake  30
+aked 3
+aken 2
+aker 4
+akes 24
+akin 8
+akne 3
+al a 6
+al c 4
+al i 4
+al m 3
+al n 2
+al o 4
+al r 3
+al t 10
+al.  4
+alad 2
+alcu 3
+ale  2
+alf  2
+alf. 2
+alk  9
+alk. 3
+alke 5
+alki 5
+all  73
+all, 4
+all. 4
+alle 19
+allo 6
+alls 7
+ally 21
+almo 10
+alon 12
+alph 9
+alre 3
+als  5
+als, 5
+also 19
+alt  13
+alt, 4
+alth 6
+alue 3
+alve 3
+alwa 9
+alys 3
+am a 2
+am b 2
+am c 2
+am,  3
+ambe 4
+ambl 3
+ame  42
+amed 2
+amer 2
+ames 4
+amil 6
+amin 2
+amma 2
+amme 2
+amon 2
+amou 5
+ampl 3
+ams  7
+an a 22
+an b 23
+an c 3
+an d 4
+an e 13
+an f 4
+an g 3
+an h 8
+an i 14
+an l 2
+an m 2
+an o 8
+an p 6
+an r 3
+an s 18
+an t 25
+an u 3
+an w 8
+anal 4
+anat 2
+ance 17
+and  423
+and, 8
+and. 2
+ande 2
+andf 2
+andi 5
+andl 2
+andm 3
+ando 3
+ands 9
+ane  7
+ane, 2
+anes 5
+anet 3
+ange 33
+angi 3
+angl 2
+angu 14
+anim 2
+anin 2
+ank  7
+anks 7
+anni 5
+anno 3
+anot 7
+ans  8
+ans, 3
+ansi 2
+answ 3
+ant  23
+ant, 2
+ant. 5
+anta 4
+ante 4
+ants 10
+any  52
+anyo 7
+anyw 2
+ap t 2
+apar 4
+ape  2
+aped 5
+aper 10
+aph  5
+aphs 4
+apor 2
+appe 16
+appl 3
+ar a 11
+ar f 3
+ar i 5
+ar m 3
+ar s 4
+ar t 9
+ar,  5
+ar.  8
+arbo 5
+arch 6
+ard  20
+ard, 3
+ard. 6
+arde 11
+ardi 3
+ards 8
+are  80
+are, 2
+are. 2
+area 3
+ared 7
+aref 7
+arel 2
+aren 5
+arge 16
+argu 4
+aria 4
+arin 4
+ark  5
+ark. 2
+arka 2
+arke 7
+arks 5
+arly 13
+arm  7
+arm, 2
+arme 4
+armi 3
+arms 5
+army 2
+arn  7
+arne 5
+arni 6
+arou 11
+arri 10
+arro 2
+arry 7
+ars  19
+ars, 9
+ars. 8
+art  25
+arte 8
+arth 6
+arti 4
+artm 4
+arts 2
+arve 3
+ary  13
+ary. 2
+as a 25
+as b 11
+as c 8
+as d 6
+as e 2
+as f 5
+as h 2
+as i 9
+as l 6
+as m 6
+as n 6
+as o 6
+as p 6
+as r 4
+as s 19
+as t 21
+as u 3
+as w 4
+as.  3
+ase  4
+ases 6
+ash  2
+asic 4
+asie 5
+ask  6
+aske 2
+aski 2
+ason 7
+ass  6
+ass. 3
+asse 5
+assw 5
+ast  20
+ast, 4
+ast. 6
+aste 15
+asti 2
+asts 3
+asur 3
+asy  5
+at a 28
+at b 3
+at c 17
+at d 8
+at e 10
+at f 12
+at g 4
+at h 13
+at i 23
+at l 10
+at m 7
+at n 6
+at o 8
+at p 5
+at r 8
+at s 20
+at t 62
+at u 3
+at w 16
+at y 5
+at,  4
+ata  3
+atch 15
+ate  15
+ate, 3
+ate. 2
+ated 11
+atel 4
+ater 38
+ates 3
+atev 4
+ath  6
+athe 26
+atic 9
+atie 7
+atin 5
+atio 24
+atis 2
+ativ 4
+atmo 3
+atoe 2
+ator 8
+ats  7
+atta 7
+atte 10
+atur 5
+augh 7
+aura 2
+ause 28
+auto 5
+ave  53
+avel 8
+aver 2
+aves 9
+avin 2
+avio 6
+avoi 3
+avor 2
+avy  3
+away 10
+awer 3
+awni 3
+axes 2
+ay a 10
+ay b 4
+ay c 2
+ay h 3
+ay i 14
+ay l 2
+ay m 2
+ay o 3
+ay r 2
+ay s 4
+ay t 21
+ay w 3
+ay,  18
+ay.  10
+ayed 5
+ayer 14
+ayin 2
+ays  26
+ays, 3
+ays. 4
+babl 2
+back 12
+badl 2
+bags 2
+bake 6
+ball 9
+bank 12
+basi 5
+be a 4
+be b 2
+be c 6
+be d 2
+be e 3
+be h 3
+be i 3
+be p 7
+be r 3
+be s 5
+be t 3
+be u 2
+beac 2
+beat 5
+beca 30
+beco 13
+bed  2
+bee  2
+been 16
+bees 9
+befo 19
+bega 3
+begi 6
+beha 6
+behi 6
+beli 4
+bell 2
+belo 3
+bend 2
+ber  14
+bere 3
+bers 15
+bes  3
+best 6
+bet  4
+bet, 2
+bets 2
+bett 5
+betw 10
+bicy 6
+bill 3
+bird 3
+bisc 2
+bit  3
+bits 5
+bjec 3
+blac 3
+ble  31
+ble, 2
+ble. 5
+bled 2
+blem 8
+bles 12
+blis 2
+bloo 7
+blue 2
+bly  3
+boar 3
+boat 3
+body 11
+bol  2
+bon  2
+bone 2
+book 14
+boot 3
+bor  2
+both 6
+bott 3
+bout 32
+bove 5
+box  2
+boxe 2
+brai 8
+brar 10
+brea 16
+brel 2
+brig 6
+brin 3
+brok 4
+bsit 4
+bsol 2
+buil 17
+burn 3
+busi 7
+busy 2
+but  42
+buy  2
+by a 3
+by b 3
+by c 2
+by h 6
+by i 2
+by l 3
+by m 4
+by o 3
+by s 3
+by t 22
+c id 2
+c, a 2
+cabl 3
+cafe 3
+cake 2
+cal  4
+calc 3
+call 16
+calm 2
+cals 3
+came 16
+can  61
+cann 2
+carb 2
+card 6
+care 9
+carr 10
+cars 2
+carv 3
+cast 6
+catc 2
+cate 3
+caus 28
+ccep 4
+cces 5
+ccid 2
+ccou 2
+ce a 12
+ce b 2
+ce c 7
+ce f 3
+ce g 2
+ce i 6
+ce m 4
+ce o 10
+ce s 3
+ce t 15
+ce w 2
+ce,  8
+ce.  13
+cean 2
+ced  7
+ceiv 3
+cent 23
+cept 7
+cert 4
+ces  30
+ces, 3
+cess 11
+ch a 7
+ch b 5
+ch c 6
+ch e 5
+ch f 9
+ch i 7
+ch k 2
+ch l 10
+ch m 11
+ch o 12
+ch p 2
+ch s 5
+ch t 4
+ch w 4
+ch,  4
+ch.  3
+chai 2
+cham 5
+chan 31
+char 2
+chea 2
+chec 5
+ched 6
+chee 2
+chem 3
+chen 6
+cher 10
+ches 12
+chil 22
+chin 15
+choo 17
+chos 4
+chti 2
+chur 3
+cial 2
+cide 5
+cidi 2
+cien 6
+cine 4
+ciou 2
+ciph 8
+circ 2
+citi 7
+city 8
+ck b 2
+ck f 2
+ck i 4
+ck n 2
+ck o 5
+ck t 6
+ck,  7
+cked 4
+cker 2
+cket 5
+ckin 2
+ckly 7
+cks  7
+cksm 2
+clas 3
+clay 3
+cle  5
+cle, 2
+cle. 2
+clea 9
+clim 10
+cloc 4
+clos 9
+clot 4
+clou 2
+clud 5
+clue 2
+coas 3
+code 2
+coff 3
+coin 5
+cold 6
+coll 5
+colo 4
+colu 2
+comb 3
+come 30
+comm 18
+comp 30
+conc 3
+cond 6
+conf 3
+conn 4
+cons 3
+cont 10
+conv 3
+cook 6
+cool 6
+cope 3
+copi 3
+cord 5
+cost 3
+coul 27
+coun 18
+cour 4
+cove 12
+cram 3
+crat 2
+crea 8
+cree 5
+cret 9
+crib 5
+crim 3
+crip 2
+cros 10
+crow 2
+cryp 8
+ct t 5
+ct.  2
+cted 6
+ctio 11
+ctiv 8
+ctly 6
+ctor 3
+ctri 2
+cts  10
+ctur 4
+cuit 3
+cula 3
+cult 8
+curi 3
+curr 3
+curv 2
+cuss 2
+cust 7
+cut  4
+cutt 3
+cycl 9
+d a  38
+d ab 3
+d ac 2
+d af 3
+d ag 3
+d al 9
+d an 20
+d ar 7
+d as 4
+d at 8
+d aw 2
+d ba 4
+d be 16
+d bi 2
+d bu 4
+d by 22
+d ca 12
+d ch 7
+d ci 2
+d cl 3
+d co 13
+d cr 4
+d cu 2
+d da 4
+d de 6
+d di 2
+d do 8
+d dr 4
+d ea 2
+d en 3
+d eu 2
+d ev 15
+d ex 2
+d ey 2
+d fa 3
+d fi 8
+d fl 3
+d fo 17
+d fr 13
+d fu 3
+d ga 2
+d go 4
+d gr 2
+d ha 3
+d he 10
+d hi 4
+d ho 5
+d i  2
+d in 34
+d is 10
+d it 19
+d ke 4
+d la 10
+d le 6
+d li 10
+d lo 8
+d ma 17
+d me 5
+d mi 3
+d mo 19
+d mu 4
+d ne 7
+d ni 2
+d no 14
+d nu 3
+d of 29
+d on 15
+d op 4
+d or 4
+d ot 2
+d ou 3
+d ov 3
+d pa 5
+d pe 10
+d ph 2
+d pi 3
+d po 3
+d pr 4
+d ra 5
+d re 12
+d sa 7
+d sc 2
+d se 13
+d sh 4
+d si 3
+d sl 2
+d so 16
+d sp 4
+d st 10
+d su 3
+d sw 2
+d ta 7
+d te 4
+d th 156
+d ti 2
+d to 58
+d tr 3
+d tu 5
+d un 2
+d up 4
+d us 4
+d ve 2
+d vo 2
+d wa 13
+d we 9
+d wh 17
+d wi 17
+d wo 4
+d wr 6
+d ye 5
+d yo 3
+d, a 13
+d, b 5
+d, c 2
+d, f 2
+d, i 2
+d, o 2
+d, p 4
+d, s 6
+d, t 10
+d, w 3
+d. a 8
+d. b 5
+d. h 3
+d. i 7
+d. l 2
+d. m 4
+d. o 4
+d. t 23
+d. w 2
+danc 4
+dang 2
+dark 8
+data 5
+date 3
+day  26
+day, 11
+day. 9
+days 12
+dded 2
+dden 4
+ddle 3
+de a 4
+de b 3
+de f 5
+de i 6
+de o 8
+de t 4
+de,  4
+de.  8
+dea  4
+deal 4
+deas 6
+deci 8
+ded  18
+deep 5
+defe 4
+den  5
+den, 2
+denc 3
+denl 2
+dens 3
+dent 9
+depe 6
+der  20
+der. 3
+dere 2
+dern 4
+ders 13
+des  3
+desc 5
+dese 3
+desi 4
+dest 3
+deta 3
+dete 5
+deve 3
+devi 4
+dful 2
+dge  4
+dges 2
+dici 4
+did  3
+dien 2
+dies 3
+diet 2
+diff 20
+dina 3
+ding 37
+dinn 3
+diox 2
+dire 5
+disa 4
+disc 7
+dise 5
+dish 5
+dist 8
+divi 3
+dle  4
+dles 3
+dly  3
+dmot 3
+dnes 2
+do n 8
+do t 3
+do.  3
+does 11
+dogs 2
+doin 3
+dom  2
+dom, 2
+door 2
+doug 9
+dow  2
+down 17
+dows 4
+drag 3
+draw 3
+drea 4
+dred 7
+dren 18
+dres 2
+drie 2
+drin 3
+driv 2
+drop 5
+drow 2
+ds a 15
+ds b 3
+ds d 2
+ds f 2
+ds g 2
+ds h 2
+ds i 7
+ds o 14
+ds s 2
+ds t 8
+ds w 5
+ds y 2
+ds,  9
+ds.  6
+duce 8
+duct 2
+dult 7
+duri 8
+dvan 6
+dy b 2
+dy c 2
+dy e 2
+dy k 2
+dy r 2
+dy s 3
+dy t 2
+dy w 2
+dy,  2
+e a  37
+e ab 2
+e ac 4
+e ad 5
+e af 7
+e ag 7
+e ai 18
+e al 11
+e am 2
+e an 36
+e ap 2
+e ar 26
+e as 8
+e at 11
+e au 3
+e av 3
+e aw 3
+e ba 17
+e be 31
+e bl 4
+e bo 17
+e br 19
+e bu 7
+e by 7
+e ca 25
+e ce 5
+e ch 22
+e ci 8
+e cl 10
+e co 46
+e cr 9
+e cu 4
+e da 27
+e de 6
+e di 15
+e do 17
+e dr 10
+e ea 14
+e el 3
+e em 2
+e en 15
+e eq 2
+e ev 18
+e ex 10
+e fa 15
+e fe 3
+e fi 31
+e fl 10
+e fo 27
+e fr 16
+e fu 2
+e ga 12
+e gl 4
+e go 5
+e gr 16
+e ha 32
+e he 18
+e hi 10
+e ho 10
+e hu 3
+e i  3
+e id 4
+e in 48
+e is 40
+e it 21
+e ju 2
+e ke 13
+e ki 3
+e kn 6
+e la 15
+e le 23
+e li 28
+e lo 13
+e ma 23
+e me 25
+e mi 7
+e mo 48
+e mu 5
+e na 4
+e ne 25
+e ni 7
+e no 12
+e nu 8
+e of 78
+e ol 8
+e on 21
+e op 6
+e or 9
+e ot 11
+e ou 9
+e ov 4
+e ow 2
+e pa 28
+e pe 19
+e ph 9
+e pi 4
+e pl 18
+e po 14
+e pr 16
+e pu 7
+e qu 5
+e ra 8
+e re 41
+e ri 18
+e ro 16
+e ru 12
+e sa 33
+e sc 9
+e se 26
+e sh 19
+e si 16
+e sk 5
+e sl 6
+e sm 6
+e so 20
+e sp 6
+e sq 5
+e st 34
+e su 22
+e sy 5
+e ta 11
+e te 15
+e th 132
+e ti 15
+e to 65
+e tr 9
+e tu 2
+e tw 9
+e un 2
+e us 12
+e va 3
+e ve 3
+e vi 12
+e wa 45
+e we 21
+e wh 29
+e wi 27
+e wo 32
+e wr 8
+e ye 4
+e yo 14
+e, a 27
+e, b 9
+e, c 6
+e, f 4
+e, h 2
+e, i 4
+e, m 2
+e, o 2
+e, s 2
+e, t 20
+e, w 2
+e. a 11
+e. b 2
+e. c 4
+e. f 5
+e. g 2
+e. i 11
+e. l 2
+e. m 5
+e. o 3
+e. p 4
+e. r 2
+e. s 11
+e. t 23
+e. u 2
+e. w 7
+e. y 2
+e: t 3
+ea a 2
+ea i 5
+ea o 2
+ea w 3
+ea,  2
+each 43
+ead  36
+ead, 6
+ead. 7
+eade 2
+eadi 4
+eads 6
+eady 4
+eak  7
+eake 2
+eaki 3
+eakn 3
+eal  11
+eali 3
+eall 4
+ealt 4
+eam  11
+eam, 2
+eams 3
+ean  6
+eani 2
+eans 7
+ear  19
+ear. 6
+earc 4
+eard 6
+eare 4
+eari 2
+earl 13
+earn 20
+ears 23
+eart 16
+eas  5
+eas. 2
+ease 9
+easi 5
+easo 7
+east 6
+easu 3
+easy 5
+eat  20
+eat, 3
+eate 10
+eath 15
+eati 3
+eats 6
+eave 13
+eavi 3
+eavy 3
+eboo 2
+ebsi 4
+ecam 7
+ecas 6
+ecau 23
+ece  6
+ecei 4
+ecen 2
+eces 6
+ecia 2
+ecid 5
+ecip 2
+ecki 2
+ecom 13
+econ 6
+ecor 4
+ecre 8
+ect  13
+ect. 2
+ecta 2
+ecte 6
+ecti 10
+ectl 2
+ectr 3
+ects 6
+ecur 2
+ed a 38
+ed b 25
+ed c 8
+ed d 7
+ed e 5
+ed f 16
+ed g 2
+ed h 6
+ed i 24
+ed l 8
+ed m 12
+ed n 2
+ed o 15
+ed p 4
+ed r 4
+ed s 13
+ed t 57
+ed u 4
+ed w 21
+ed y 3
+ed,  13
+ed.  21
+eded 4
+edge 4
+edic 5
+edie 2
+edit 2
+eds  11
+eds. 2
+educ 4
+ee a 6
+ee m 2
+ee p 2
+ee t 4
+ee w 4
+ee,  3
+eed  17
+eed. 2
+eede 4
+eeds 10
+eek  4
+eeks 6
+eel  5
+eeli 2
+eem  5
+eems 9
+een  34
+een, 5
+een. 2
+eent 3
+eep  19
+eep, 4
+eep. 2
+eepe 5
+eepi 6
+eeps 2
+eer  5
+eers 3
+ees  11
+eese 2
+eet  7
+eeti 2
+eets 6
+efea 3
+efer 4
+effo 5
+efle 2
+efor 19
+efri 2
+eft  6
+eful 12
+egan 3
+eget 6
+egin 6
+egio 2
+egra 2
+egul 4
+ehav 6
+ehin 6
+eigh 10
+eir  47
+eive 3
+ek a 2
+eks  4
+eks, 2
+el a 4
+el f 3
+el,  2
+elat 5
+eld  5
+elds 2
+elea 3
+elec 3
+elep 5
+eler 2
+eles 4
+elf  3
+elf, 2
+elia 2
+elie 5
+elin 4
+ell  16
+ell. 2
+ella 3
+elle 3
+elli 2
+ello 4
+ells 6
+elon 3
+elop 4
+elp  4
+elp. 2
+elps 9
+els  5
+else 7
+elt  2
+elve 5
+ely  9
+ely, 3
+ely. 3
+em a 3
+em i 5
+em o 2
+em t 8
+em w 5
+em,  6
+em.  3
+emar 2
+emat 3
+embe 12
+emem 10
+emen 8
+emic 3
+emor 5
+empl 5
+empt 3
+ems  14
+ems. 2
+emse 3
+emy  2
+en a 28
+en b 9
+en c 7
+en d 8
+en f 7
+en h 6
+en i 16
+en l 6
+en m 5
+en n 3
+en p 8
+en r 4
+en s 16
+en t 45
+en u 4
+en w 15
+en y 4
+en,  19
+en.  11
+ence 17
+enci 3
+encr 6
+end  31
+end, 3
+end. 3
+ende 2
+endi 2
+endl 2
+ends 9
+ened 3
+enem 2
+ener 8
+enge 2
+engi 5
+engl 3
+engt 7
+enin 20
+enjo 2
+enly 3
+enou 7
+ens  4
+ens, 2
+ense 3
+ensi 3
+ent  39
+ent' 2
+ent, 8
+ent. 3
+ente 7
+enth 5
+enti 18
+entl 4
+ento 4
+entr 6
+ents 28
+entu 12
+enty 5
+eone 9
+eopl 57
+eory 2
+ep a 3
+ep i 5
+ep s 2
+ep t 6
+ep,  4
+ep.  2
+epai 3
+epar 6
+epea 3
+epen 6
+eper 5
+epho 5
+epin 6
+epla 5
+epor 2
+eps  3
+ept  8
+epti 2
+equa 2
+equi 8
+er a 42
+er b 13
+er c 17
+er d 7
+er e 5
+er f 14
+er g 4
+er h 12
+er i 22
+er l 9
+er m 8
+er n 8
+er o 28
+er p 7
+er r 4
+er s 24
+er t 63
+er u 3
+er w 21
+er,  41
+er.  40
+erab 3
+erag 2
+eral 13
+erat 10
+erbs 2
+ere  89
+ere, 2
+ered 16
+eree 2
+eren 13
+erfe 2
+erfu 5
+ergy 3
+eric 2
+erie 5
+erin 2
+erio 5
+erly 2
+erma 2
+ern  5
+erne 2
+ernm 4
+erno 8
+erns 2
+eroe 2
+erou 2
+erro 2
+ers  94
+ers, 18
+ers. 10
+ersa 3
+erso 6
+erst 8
+erta 4
+erts 2
+erve 5
+ervi 4
+ery  58
+eryo 10
+eryt 5
+es a 64
+es b 9
+es c 8
+es d 2
+es e 3
+es f 9
+es g 3
+es h 7
+es i 19
+es l 6
+es m 7
+es n 15
+es o 27
+es p 7
+es r 7
+es s 18
+es t 52
+es u 2
+es w 29
+es,  42
+es.  33
+es:  2
+esco 2
+escr 4
+ese  13
+esea 2
+eser 5
+esh  2
+esig 4
+espe 3
+ess  31
+ess, 5
+ess. 5
+essa 21
+esse 9
+essf 4
+essi 3
+esso 2
+est  28
+esta 2
+esti 8
+esto 2
+ests 8
+esul 2
+et a 4
+et b 2
+et c 2
+et e 6
+et f 2
+et i 9
+et l 4
+et m 5
+et o 7
+et p 2
+et s 2
+et t 9
+et u 3
+et w 2
+et,  6
+et.  4
+etab 6
+etai 3
+etal 3
+etec 5
+etee 2
+etel 3
+eth  3
+ethe 12
+ethi 9
+etho 8
+etim 5
+etin 2
+etir 3
+etit 3
+ets  10
+ets, 2
+ets. 3
+ette 33
+etti 4
+etur 4
+etwe 10
+etwo 4
+euro 4
+eve  4
+evel 3
+even 33
+ever 94
+evic 4
+evid 3
+ew b 6
+ew c 3
+ew d 5
+ew h 4
+ew i 4
+ew m 4
+ew p 3
+ew s 3
+ew t 3
+ew y 2
+ewar 8
+ewco 2
+ews  2
+ewsp 4
+exac 4
+exam 3
+exce 3
+exch 5
+exci 2
+exis 3
+expe 10
+expl 3
+ext  11
+extr 3
+ey a 12
+ey b 6
+ey c 13
+ey d 5
+ey f 4
+ey g 3
+ey h 8
+ey i 5
+ey k 3
+ey l 4
+ey n 2
+ey o 4
+ey p 2
+ey r 3
+ey s 11
+ey t 3
+ey u 2
+ey w 12
+ey,  4
+ey.  3
+eye  3
+eywo 6
+f a  27
+f ai 2
+f an 3
+f bo 3
+f ch 4
+f co 3
+f da 2
+f di 3
+f en 2
+f fl 2
+f fo 6
+f go 2
+f gr 5
+f ha 3
+f he 3
+f ho 2
+f hu 2
+f in 2
+f it 4
+f la 2
+f le 3
+f li 3
+f me 3
+f mo 4
+f nu 2
+f of 3
+f ou 4
+f pa 2
+f pe 4
+f pl 2
+f pr 2
+f re 2
+f ro 2
+f sa 4
+f se 2
+f si 2
+f sl 3
+f so 6
+f st 5
+f th 128
+f ti 2
+f to 2
+f tr 3
+f us 3
+f we 3
+f wh 3
+f wr 2
+f ye 4
+f yo 2
+f, a 3
+f. f 2
+face 5
+fact 5
+fail 3
+fall 5
+fami 6
+famo 2
+far  8
+farm 8
+fast 12
+fe h 2
+fe,  2
+fe.  4
+feat 3
+fect 4
+fee  2
+feed 3
+feel 8
+fell 2
+felt 2
+fere 16
+fers 2
+fes  2
+few  19
+ffec 2
+ffee 3
+ffer 17
+ffic 10
+ffor 5
+ffsi 2
+fice 3
+ficu 7
+fiel 6
+fift 4
+fill 4
+film 2
+fina 9
+find 13
+fing 2
+firs 31
+fish 8
+five 5
+flas 3
+flat 3
+flav 2
+flec 2
+floo 2
+flou 5
+flow 11
+flue 2
+fly  3
+fold 5
+foll 6
+food 9
+foot 4
+for  99
+forc 2
+fore 30
+forg 4
+form 14
+fort 12
+forw 3
+foul 2
+four 6
+free 4
+fres 4
+frie 9
+frig 2
+from 56
+fron 5
+frui 5
+fsid 2
+ft s 2
+ft t 3
+ft.  2
+fted 4
+ftee 2
+ften 25
+fter 19
+fty  2
+fuel 3
+ful  19
+ful. 3
+full 6
+furt 2
+fuse 2
+g a  17
+g ab 3
+g an 13
+g as 5
+g at 3
+g ba 2
+g be 6
+g by 2
+g ca 3
+g ch 2
+g cl 2
+g co 4
+g da 2
+g di 5
+g ea 3
+g el 3
+g ev 3
+g fo 5
+g fr 4
+g ha 2
+g he 3
+g ho 3
+g in 15
+g is 7
+g it 5
+g le 2
+g li 2
+g ma 2
+g mo 3
+g no 2
+g of 4
+g on 6
+g or 4
+g ov 2
+g pa 4
+g pe 2
+g ro 3
+g se 3
+g sh 2
+g si 2
+g so 6
+g st 2
+g su 2
+g sy 3
+g th 57
+g ti 3
+g to 15
+g un 2
+g up 4
+g wa 2
+g wh 3
+g wi 9
+g wo 6
+g, a 7
+g, b 4
+g, s 2
+g, t 2
+g, w 3
+g. i 6
+g. l 2
+g. t 4
+gain 16
+game 9
+gan  3
+gani 3
+gard 9
+gas  2
+gase 2
+gath 4
+gave 2
+ge a 7
+ge c 9
+ge e 2
+ge f 5
+ge h 3
+ge i 7
+ge m 2
+ge n 2
+ge o 6
+ge p 2
+ge s 4
+ge t 4
+ge w 4
+ge,  7
+ge.  7
+ged  7
+gen  2
+gene 5
+gent 2
+ger  19
+ger. 3
+gera 2
+gero 2
+gers 2
+ges  24
+ges, 4
+ges. 5
+gest 4
+get  5
+geta 6
+geth 7
+gett 3
+gges 3
+gh a 5
+gh e 2
+gh f 4
+gh i 7
+gh s 5
+gh t 6
+gh,  5
+gh.  2
+ght  49
+ght, 6
+ght. 7
+ghte 3
+ghts 8
+gine 6
+ging 6
+ginn 2
+gins 4
+gion 2
+give 12
+givi 2
+glas 3
+gle  12
+glis 3
+glut 4
+gly  2
+gnal 4
+gns  4
+go,  2
+go.  3
+goal 7
+goin 2
+gold 2
+good 14
+gove 4
+grai 4
+gram 12
+gran 5
+grap 12
+gras 3
+grea 8
+gred 2
+gree 6
+grew 5
+grey 2
+grou 7
+grow 17
+gs a 10
+gs c 2
+gs i 3
+gs o 5
+gs,  4
+gs.  4
+gth  5
+guag 13
+guar 2
+gue  3
+gues 4
+gula 5
+gume 2
+h a  21
+h an 9
+h as 6
+h be 5
+h ca 4
+h ce 7
+h ch 2
+h co 5
+h de 2
+h do 2
+h ea 6
+h en 2
+h ex 3
+h fa 2
+h fo 8
+h fr 3
+h he 4
+h hi 2
+h i  3
+h in 7
+h is 9
+h it 5
+h la 3
+h le 4
+h li 2
+h lo 2
+h ma 2
+h me 2
+h mo 11
+h of 10
+h on 4
+h ot 8
+h ou 2
+h pl 2
+h pr 4
+h re 3
+h sh 4
+h sl 3
+h st 3
+h ta 2
+h th 24
+h ti 2
+h to 2
+h wa 3
+h, a 4
+h, b 3
+h, s 2
+h, t 4
+h, w 3
+h. a 2
+h. i 2
+h. p 2
+h. t 5
+habe 9
+habi 8
+had  27
+hair 4
+half 5
+hall 5
+hamb 4
+han  42
+hand 10
+hang 29
+hank 3
+hape 8
+happ 5
+harb 3
+hard 9
+hare 2
+has  37
+hat  183
+hate 4
+have 43
+havi 6
+hcha 2
+he a 45
+he b 52
+he c 60
+he d 41
+he e 36
+he f 58
+he g 28
+he h 39
+he i 17
+he k 16
+he l 50
+he m 67
+he n 35
+he o 36
+he p 62
+he q 4
+he r 55
+he s 126
+he t 50
+he u 2
+he v 13
+he w 90
+he y 12
+head 11
+heal 3
+heap 2
+hear 22
+heat 6
+heav 4
+heck 5
+hed  11
+hed, 2
+hees 2
+heet 4
+heir 47
+held 2
+hell 2
+help 15
+helv 2
+hem  15
+hem, 5
+hem. 3
+hema 3
+hemi 3
+hems 3
+hen  64
+hen, 3
+heor 2
+her  82
+her, 11
+her. 10
+hera 2
+herb 2
+here 62
+hero 2
+hers 11
+hes  12
+hes. 2
+hese 11
+hess 6
+heth 5
+hey  76
+hich 17
+hick 2
+hift 7
+high 4
+hild 22
+hile 12
+hill 4
+him  2
+hin  2
+hina 2
+hind 6
+hine 9
+hing 37
+hink 7
+hips 2
+hirt 2
+his  29
+hist 11
+hite 2
+hive 3
+ho a 2
+ho c 4
+ho d 3
+ho e 2
+ho g 2
+ho h 9
+ho i 2
+ho m 4
+ho r 3
+ho s 3
+ho t 4
+hod  5
+hods 2
+hold 6
+hole 15
+homa 3
+home 6
+hone 20
+hool 12
+hoos 5
+hop  3
+hop, 3
+hort 11
+hose 8
+hot  7
+hoto 10
+houg 5
+houl 3
+hour 11
+hous 19
+hout 13
+how  22
+howe 5
+hown 3
+hows 4
+hree 4
+hrou 10
+hs o 2
+hs t 3
+hs,  4
+ht a 6
+ht b 3
+ht c 4
+ht d 2
+ht f 4
+ht i 2
+ht m 3
+ht o 4
+ht p 2
+ht s 6
+ht t 5
+ht w 4
+ht,  6
+ht.  7
+htes 2
+htim 2
+hts  5
+hts, 3
+huma 6
+hund 8
+hung 3
+hurc 3
+hy w 2
+hy y 2
+hysi 2
+i ha 6
+i le 2
+i sh 2
+i th 2
+ia a 2
+iabl 2
+ial  2
+ians 2
+ibe  3
+ibes 2
+ible 16
+ibra 11
+ic c 2
+ic d 2
+ic i 2
+ic,  3
+ical 8
+icat 3
+ice  13
+ice. 2
+iced 3
+icer 2
+ices 12
+ich  20
+icin 4
+ick  5
+icke 4
+ickl 7
+ics  5
+ictu 3
+icul 7
+icyc 6
+id e 2
+id n 2
+id t 5
+iddl 3
+ide  21
+ide, 3
+ide. 5
+idea 10
+ided 4
+iden 5
+ider 2
+ides 2
+idin 5
+iece 10
+ied  8
+ield 6
+ienc 7
+iend 8
+ient 14
+ier  4
+iers 2
+ies  27
+ies, 9
+ies. 2
+iest 2
+iet  7
+ieth 3
+ieve 4
+iew  2
+if a 2
+if t 13
+if y 2
+ife  5
+ife, 2
+ife. 2
+iffe 13
+iffi 7
+ift  3
+ift. 2
+ifte 6
+ifty 2
+igen 2
+iger 2
+igh  3
+ight 72
+ign  4
+igna 4
+igns 5
+ike  10
+ikel 3
+il a 3
+il h 2
+il m 2
+il n 3
+il t 4
+il.  3
+ilar 3
+ild  9
+ildi 6
+ildr 18
+ile  13
+iles 2
+ilie 2
+ilin 3
+ill  34
+ill, 4
+illa 9
+ille 2
+illi 5
+ilms 2
+ilot 3
+ils  4
+ils, 2
+ilt  6
+ilve 2
+ily  5
+im o 2
+imal 2
+imat 6
+imb  2
+ime  17
+ime, 10
+ime. 7
+imes 12
+imil 3
+imit 2
+immi 2
+impl 27
+impo 22
+impr 4
+in 1 3
+in a 44
+in b 5
+in c 10
+in d 4
+in e 9
+in f 10
+in g 3
+in h 4
+in i 7
+in l 7
+in m 9
+in o 4
+in p 6
+in r 3
+in s 19
+in t 96
+in u 2
+in w 9
+in,  6
+in.  5
+inal 10
+inar 3
+ince 3
+incl 5
+inco 2
+ind  24
+ind. 3
+inde 5
+indi 4
+indo 7
+inds 3
+ine  14
+ine. 3
+ined 7
+inee 2
+iner 2
+ines 13
+inet 3
+info 4
+ing  258
+ing, 20
+ing. 17
+inge 2
+ingl 13
+ingr 2
+ings 25
+inin 2
+ink  7
+inke 2
+inki 2
+inks 2
+inla 2
+inne 4
+inni 2
+ins  10
+ins, 3
+ins. 3
+insi 6
+inst 17
+insu 2
+int  3
+inte 15
+inti 2
+into 36
+ints 3
+inue 2
+inut 9
+inve 12
+invi 2
+iny  2
+iods 2
+ion  33
+ion. 7
+iona 2
+ions 33
+ior  5
+ious 7
+ioxi 2
+ip t 2
+ip,  2
+iphe 8
+ipme 2
+ippe 2
+ipt  2
+ir a 7
+ir b 4
+ir f 3
+ir g 2
+ir h 5
+ir i 5
+ir l 5
+ir m 2
+ir o 6
+ir p 6
+ir r 4
+ir s 2
+ir t 4
+ir v 2
+ir w 8
+irds 2
+ire  3
+ire, 2
+irec 5
+ired 7
+ires 3
+irpl 14
+irs  3
+irst 31
+irty 2
+is a 23
+is b 3
+is c 17
+is d 4
+is e 6
+is f 4
+is g 2
+is h 6
+is i 9
+is j 2
+is k 4
+is l 13
+is m 10
+is n 17
+is o 11
+is p 13
+is r 7
+is s 32
+is t 33
+is u 4
+is v 4
+is w 15
+is,  2
+isap 3
+isco 5
+iscu 4
+ise  11
+ise, 3
+isea 5
+ises 4
+ish  8
+ish, 2
+ishe 9
+isin 5
+isit 2
+isk  2
+ista 14
+iste 6
+isti 3
+isto 13
+ists 13
+it a 8
+it b 5
+it c 9
+it d 4
+it e 2
+it f 7
+it g 4
+it h 8
+it i 51
+it l 4
+it n 2
+it o 4
+it p 5
+it r 6
+it s 13
+it t 15
+it w 14
+it,  9
+it.  17
+itch 8
+ite  12
+ite. 2
+ited 3
+iter 3
+ites 5
+ith  75
+ithi 2
+itho 11
+itie 7
+itin 16
+itio 8
+itne 2
+its  19
+its. 2
+itse 4
+itte 7
+ittl 10
+ity  9
+ity, 2
+ity. 3
+ive  34
+ive, 3
+ive. 3
+ived 6
+iven 2
+iver 14
+ives 15
+ivid 3
+ivin 7
+ize  3
+ject 3
+job  2
+join 2
+jor  2
+jour 3
+judg 2
+jupi 2
+just 5
+k a  2
+k ab 5
+k an 6
+k as 2
+k co 2
+k fo 5
+k ha 5
+k ho 2
+k in 5
+k is 3
+k no 2
+k of 11
+k or 4
+k ou 2
+k th 12
+k to 9
+k up 2
+k wh 2
+k wi 3
+k yo 3
+k, a 6
+k, t 3
+k. n 2
+k. o 2
+kabl 2
+ke a 10
+ke c 2
+ke d 4
+ke e 3
+ke i 3
+ke m 2
+ke o 3
+ke p 3
+ke t 6
+ke w 2
+ke.  2
+ked  26
+ked. 2
+keep 18
+kely 3
+ken  4
+kept 4
+kers 8
+kery 2
+kes  19
+kes, 3
+kes. 2
+ket  7
+kets 3
+key  6
+key. 2
+keyw 6
+kind 5
+king 32
+kitc 6
+kly, 4
+kly. 2
+knea 2
+knes 4
+knew 2
+knig 2
+know 22
+ks a 8
+ks b 2
+ks c 3
+ks h 2
+ks i 2
+ks o 2
+ks t 5
+ks w 6
+ks,  10
+ks.  3
+ksho 2
+ksmi 2
+ky i 2
+l a  2
+l ab 2
+l al 4
+l an 13
+l ap 4
+l at 4
+l be 2
+l bu 5
+l ca 5
+l ch 3
+l cl 2
+l co 5
+l cu 2
+l de 2
+l en 2
+l ev 2
+l fe 2
+l fo 5
+l fr 2
+l ga 2
+l gr 2
+l ha 6
+l he 2
+l hi 2
+l ho 2
+l in 12
+l is 5
+l la 2
+l ma 2
+l me 3
+l mi 2
+l mo 3
+l ne 3
+l no 4
+l of 15
+l on 2
+l ov 2
+l pi 3
+l pl 5
+l re 4
+l ro 2
+l sp 2
+l st 8
+l ta 2
+l te 2
+l th 21
+l to 3
+l up 2
+l us 3
+l, a 6
+l, b 2
+l, i 2
+l, o 2
+l, s 2
+l, t 4
+l. a 5
+l. g 2
+l. i 3
+l. t 7
+lace 20
+lack 4
+lage 9
+laid 2
+lain 2
+lan  4
+lana 2
+land 11
+lane 18
+lang 13
+lann 5
+lant 11
+lar  9
+larg 16
+lary 2
+lash 2
+lass 6
+last 11
+lat  3
+late 13
+lati 8
+laug 5
+lavo 2
+law  2
+lay  7
+laye 18
+lays 5
+lcul 3
+ld a 6
+ld b 7
+ld c 3
+ld d 4
+ld f 2
+ld h 2
+ld i 2
+ld l 6
+ld m 5
+ld n 4
+ld o 4
+ld p 4
+ld r 3
+ld s 10
+ld t 9
+ld w 7
+ld,  6
+ld.  11
+lded 2
+lder 10
+ldes 2
+ldin 9
+ldre 18
+lds  3
+le a 14
+le b 5
+le c 12
+le d 5
+le e 6
+le f 9
+le g 4
+le h 5
+le i 9
+le l 3
+le m 7
+le n 2
+le o 14
+le p 6
+le r 8
+le s 15
+le t 24
+le u 3
+le v 2
+le w 15
+le y 2
+le,  11
+le.  13
+lead 2
+leak 2
+lean 3
+lear 26
+leas 6
+leav 15
+lect 9
+led  19
+leep 15
+left 7
+lem  4
+lems 4
+len  4
+leng 6
+leph 5
+ler  3
+ler, 2
+lers 3
+les  26
+les, 2
+les. 4
+lesc 2
+less 15
+let  2
+lete 3
+lets 2
+lett 28
+ley  2
+lf o 3
+lf,  3
+lf.  3
+liab 2
+libr 10
+lies 6
+liev 4
+life 8
+lift 3
+ligh 21
+like 14
+lima 6
+limb 4
+limi 2
+line 2
+ling 9
+link 3
+lion 5
+lish 6
+list 8
+lit  2
+litt 10
+live 14
+livi 5
+lk o 2
+lk t 4
+lk.  3
+lked 4
+lkin 5
+ll a 17
+ll b 7
+ll c 11
+ll e 5
+ll f 7
+ll g 5
+ll h 9
+ll i 10
+ll m 3
+ll n 4
+ll o 12
+ll p 4
+ll r 2
+ll s 11
+ll t 10
+ll u 6
+ll w 2
+ll,  9
+ll.  7
+lla  2
+llag 9
+llec 4
+lled 13
+llen 5
+ller 6
+lley 3
+llio 5
+llow 16
+lls  11
+lls, 4
+lly  15
+lly, 6
+lmos 10
+lms, 2
+load 2
+loaf 6
+lock 5
+lone 4
+long 46
+lood 8
+look 18
+loop 4
+lope 4
+lors 2
+lose 6
+losi 5
+lost 4
+loth 4
+lots 2
+loud 2
+lour 5
+love 4
+low  15
+lowe 12
+lowi 2
+lowl 5
+lows 9
+loye 2
+lp t 3
+lp.  2
+lpha 9
+lps  9
+lrea 3
+ls a 8
+ls h 2
+ls i 4
+ls o 3
+ls s 3
+ls t 3
+ls w 3
+ls,  12
+lse  3
+lse. 3
+lso  19
+lt i 6
+lt o 2
+lt s 2
+lt t 6
+lt w 4
+lt,  5
+lt.  3
+lth  3
+ltho 2
+ltie 2
+ltin 2
+lts  5
+ludi 4
+lue  2
+luen 2
+lues 2
+lumn 2
+lunc 3
+lunt 4
+lute 6
+lver 2
+lves 10
+lway 10
+ly a 26
+ly b 7
+ly e 2
+ly h 4
+ly i 7
+ly k 2
+ly l 4
+ly m 4
+ly o 8
+ly p 3
+ly r 4
+ly s 6
+ly t 19
+ly w 8
+ly,  23
+ly.  10
+lysi 2
+m a  5
+m al 2
+m an 3
+m be 2
+m ca 3
+m co 3
+m do 2
+m ha 2
+m he 3
+m hi 2
+m in 2
+m is 5
+m ki 2
+m of 2
+m on 4
+m or 2
+m si 3
+m th 30
+m to 7
+m wa 2
+m wh 2
+m wi 3
+m wo 2
+m, a 6
+m, b 2
+m, s 2
+m, t 4
+m, w 2
+m. e 2
+m. t 3
+mach 9
+made 15
+main 6
+majo 2
+make 22
+maki 3
+mal  2
+mall 41
+man  21
+many 42
+mar  2
+marc 2
+mari 3
+mark 10
+mas  3
+matc 3
+mate 8
+math 3
+mati 12
+mato 2
+matt 5
+may  5
+mb,  2
+mber 33
+mble 4
+mbol 3
+mbre 2
+me a 8
+me b 5
+me c 3
+me e 5
+me f 6
+me h 2
+me i 5
+me l 5
+me m 2
+me o 17
+me p 8
+me r 2
+me s 8
+me t 24
+me w 6
+me,  13
+me.  13
+meal 6
+mean 10
+meas 2
+meat 2
+med  7
+medi 7
+meet 3
+mell 3
+memb 12
+memo 5
+ment 29
+meon 9
+mer  12
+mer, 2
+meri 2
+mers 9
+mes  22
+mes. 2
+mess 19
+met  2
+meta 3
+meth 16
+meti 5
+mica 3
+midd 3
+migh 7
+mila 3
+mili 3
+mill 2
+mily 4
+mind 2
+mine 4
+ming 9
+minu 9
+miss 2
+mist 6
+mith 2
+mmar 2
+mmed 2
+mmer 9
+mmin 3
+mmon 11
+mmun 5
+mode 3
+mome 6
+mon  10
+mone 13
+mong 3
+mont 8
+moon 2
+moot 3
+more 48
+mori 2
+morn 9
+mory 3
+mosp 3
+most 45
+moth 4
+moun 7
+mous 3
+move 21
+movi 2
+mpan 2
+mpet 2
+mple 27
+mplo 3
+mply 9
+mpor 19
+mpos 4
+mpre 3
+mpro 2
+mpty 3
+mput 17
+ms a 7
+ms b 3
+ms o 3
+ms t 9
+ms w 3
+ms,  5
+ms.  6
+msel 4
+much 25
+mud, 2
+muni 5
+musc 2
+musi 2
+must 3
+my c 2
+my g 2
+n 19 2
+n a  62
+n ab 3
+n ad 3
+n ai 4
+n al 7
+n am 2
+n an 31
+n as 6
+n at 7
+n ba 2
+n be 27
+n bo 3
+n br 3
+n bu 3
+n by 4
+n ca 11
+n ch 5
+n ci 3
+n co 9
+n cu 3
+n da 2
+n de 6
+n di 6
+n do 5
+n ea 2
+n el 2
+n en 3
+n eu 2
+n ev 9
+n ex 4
+n fa 3
+n fi 4
+n fo 6
+n fr 9
+n ga 3
+n ge 2
+n ha 5
+n he 9
+n hi 2
+n ho 5
+n hu 3
+n i  3
+n in 23
+n is 10
+n it 14
+n la 10
+n le 4
+n li 2
+n lo 3
+n ma 7
+n me 4
+n mi 5
+n mo 3
+n ne 2
+n no 3
+n of 13
+n ol 4
+n on 8
+n op 2
+n ou 4
+n pa 4
+n pe 2
+n pl 4
+n po 3
+n pr 11
+n pu 3
+n ra 2
+n re 6
+n ri 3
+n sa 10
+n sc 2
+n se 12
+n sh 6
+n si 7
+n sm 2
+n so 6
+n sp 6
+n st 8
+n su 2
+n ta 3
+n te 5
+n th 178
+n ti 2
+n to 26
+n tr 2
+n tw 2
+n um 2
+n un 4
+n us 5
+n vi 2
+n wa 7
+n we 6
+n wh 9
+n wi 11
+n wo 6
+n yo 5
+n, a 12
+n, i 3
+n, o 3
+n, s 4
+n, t 8
+n, w 5
+n. a 2
+n. b 3
+n. h 2
+n. i 9
+n. m 5
+n. s 2
+n. t 11
+n. v 2
+n. w 5
+nage 2
+nal  5
+nall 9
+naly 4
+name 4
+narr 2
+nary 4
+nate 2
+nati 5
+nce  27
+nce, 4
+nce. 8
+nced 3
+ncen 2
+ncer 2
+nces 6
+ncht 2
+ncie 2
+ncil 3
+nclu 5
+ncre 2
+ncry 6
+nd a 40
+nd b 12
+nd c 26
+nd d 11
+nd e 19
+nd f 22
+nd g 7
+nd h 14
+nd i 26
+nd k 3
+nd l 17
+nd m 24
+nd n 13
+nd o 29
+nd p 20
+nd r 8
+nd s 39
+nd t 136
+nd u 4
+nd v 2
+nd w 29
+nd y 4
+nd,  13
+nd.  12
+ndec 2
+nded 2
+nder 22
+ndfu 2
+ndia 2
+ndin 9
+ndle 3
+ndly 2
+ndmo 3
+ndom 3
+ndow 7
+ndre 7
+nds  24
+nds, 4
+ne a 8
+ne b 8
+ne c 5
+ne d 3
+ne e 4
+ne h 6
+ne i 4
+ne m 3
+ne n 3
+ne o 21
+ne p 5
+ne r 4
+ne s 4
+ne t 5
+ne w 12
+ne,  9
+ne.  6
+nead 2
+near 7
+nece 2
+nect 5
+ned  16
+ned. 2
+need 24
+neer 3
+nels 2
+nely 2
+nemy 2
+nent 4
+ner  6
+ner, 2
+nera 4
+nerg 2
+ners 6
+nes  20
+nes, 3
+nes. 3
+ness 15
+net. 2
+nete 2
+netw 4
+neve 12
+new  19
+newc 2
+news 6
+next 9
+ney  16
+ney, 3
+nfor 7
+nfus 3
+ng a 47
+ng b 12
+ng c 11
+ng d 6
+ng e 11
+ng f 11
+ng g 2
+ng h 9
+ng i 26
+ng l 5
+ng m 7
+ng n 3
+ng o 19
+ng p 8
+ng r 4
+ng s 24
+ng t 77
+ng u 7
+ng w 22
+ng,  23
+ng.  20
+nge  12
+nge. 3
+nged 7
+nger 20
+nges 9
+ngin 9
+ngle 13
+ngli 3
+ngly 2
+ngre 2
+ngs  23
+ngs, 3
+ngs. 2
+ngth 7
+ngua 13
+nic  2
+nica 2
+nigh 20
+nima 2
+nine 4
+ning 57
+niti 3
+nity 2
+njoy 2
+nk a 6
+nk o 3
+nk y 2
+nked 2
+nkin 2
+nkno 2
+nks  6
+nks, 2
+nlan 2
+nles 2
+nly  27
+nly, 2
+nmen 4
+nnec 5
+nnel 2
+nner 4
+nnin 10
+nnot 2
+no l 5
+no m 2
+nobo 7
+nois 3
+noon 8
+norm 2
+nort 4
+not  55
+note 5
+noth 9
+noti 4
+noug 7
+nove 2
+now  17
+now. 2
+nown 7
+nows 3
+ns a 15
+ns b 6
+ns f 3
+ns h 2
+ns i 3
+ns l 2
+ns o 14
+ns t 6
+ns u 2
+ns w 4
+ns,  13
+ns.  14
+nse. 2
+nsid 6
+nsis 3
+nsiv 3
+nst  5
+nste 8
+nstr 3
+nsur 2
+nswe 3
+nt a 10
+nt c 2
+nt d 3
+nt e 2
+nt h 2
+nt i 8
+nt m 2
+nt o 10
+nt p 5
+nt s 7
+nt t 12
+nt w 2
+nt's 2
+nt,  13
+nt.  9
+ntag 4
+ntai 8
+nted 7
+ntee 5
+nter 15
+nth  6
+nths 6
+ntie 3
+ntil 3
+ntin 9
+ntio 7
+ntir 2
+ntis 5
+ntly 4
+nto  37
+ntor 4
+ntra 6
+ntri 4
+ntro 3
+ntry 6
+nts  34
+nts, 6
+ntur 12
+nty  5
+nue  2
+numb 17
+nute 9
+nutr 2
+nven 9
+nver 3
+nves 3
+nvit 2
+nwar 2
+ny a 4
+ny c 6
+ny d 4
+ny e 2
+ny h 2
+ny l 2
+ny m 2
+ny o 9
+ny p 11
+ny s 6
+ny t 2
+ny w 2
+ny y 2
+nyon 7
+nywh 2
+o a  19
+o ad 2
+o an 6
+o ar 2
+o as 3
+o be 13
+o br 3
+o bu 2
+o ca 7
+o ch 8
+o cl 6
+o co 18
+o de 5
+o di 4
+o do 5
+o ea 4
+o ev 2
+o fa 2
+o fe 2
+o fi 7
+o fo 3
+o ge 2
+o gi 3
+o gr 5
+o ha 14
+o he 5
+o hi 2
+o im 3
+o is 2
+o it 3
+o ju 2
+o ke 7
+o kn 3
+o la 4
+o le 7
+o li 4
+o lo 11
+o ma 10
+o mi 2
+o mo 5
+o mu 4
+o no 10
+o on 3
+o pa 3
+o pe 3
+o pl 6
+o pr 6
+o qu 2
+o re 24
+o ri 4
+o ro 3
+o sa 3
+o sc 2
+o se 10
+o sh 4
+o si 2
+o sl 2
+o so 2
+o sp 6
+o st 11
+o su 4
+o sw 2
+o ta 4
+o te 6
+o th 69
+o to 3
+o tr 8
+o un 4
+o up 2
+o us 4
+o wa 5
+o we 3
+o wi 4
+o wo 6
+o wr 3
+o yo 2
+o'cl 3
+o, w 2
+o. s 2
+oad  3
+oaf  4
+oal  5
+oard 3
+oast 3
+oat  2
+obab 2
+obje 3
+oble 8
+obod 7
+ocea 2
+oces 4
+ock  3
+ock, 4
+ocke 3
+ocks 2
+od a 4
+od i 3
+od l 2
+od m 2
+od s 2
+od t 4
+od w 3
+od,  4
+od.  4
+oday 12
+ode  2
+oder 3
+ods  7
+oduc 7
+ody  10
+oes  14
+oes. 2
+of a 33
+of b 6
+of c 11
+of d 6
+of e 6
+of f 11
+of g 7
+of h 10
+of i 6
+of l 10
+of m 10
+of n 3
+of o 9
+of p 13
+of r 4
+of s 24
+of t 122
+of u 4
+of w 9
+of y 4
+off  2
+offe 6
+offi 3
+offs 2
+oft  3
+ofte 25
+oget 7
+ogra 20
+oice 5
+oidi 2
+oil  9
+oil. 2
+oin  4
+oing 5
+oins 3
+oint 3
+oise 2
+ok a 3
+ok f 3
+ok o 2
+ok u 2
+ok w 2
+oke  2
+oked 8
+oken 4
+okin 5
+oks  10
+oks, 4
+oksh 2
+ol a 5
+ol e 2
+ol i 2
+ol t 5
+ol,  3
+ol.  2
+old  16
+old, 3
+old. 4
+olde 13
+oldi 4
+olds 2
+ole  17
+oles 3
+olle 7
+ollo 7
+olon 2
+olor 2
+ols  4
+olum 2
+olun 4
+olut 2
+olve 3
+om a 6
+om c 4
+om f 2
+om h 6
+om m 3
+om o 4
+om s 6
+om t 28
+om w 4
+om,  7
+oman 9
+omas 3
+omat 7
+ome  45
+ome, 2
+ome. 4
+omen 7
+omeo 9
+omer 10
+omes 9
+omet 15
+omew 2
+ommo 11
+ommu 6
+ompa 3
+ompe 2
+ompl 6
+ompu 17
+oms  2
+on a 27
+on b 3
+on c 4
+on d 4
+on e 3
+on h 2
+on i 10
+on m 3
+on o 15
+on p 5
+on r 2
+on s 8
+on t 41
+on w 4
+on,  8
+on.  15
+once 14
+ond  5
+onde 2
+onds 2
+one  78
+one, 6
+onel 2
+onen 4
+ones 16
+oney 17
+onfu 3
+ong  44
+ong, 2
+ong. 2
+onge 11
+onle 2
+only 25
+onne 4
+ons  28
+ons, 4
+ons. 6
+onsi 2
+ont  3
+onta 4
+onth 8
+onti 2
+ontr 3
+onve 3
+ony  2
+oo m 2
+oo s 2
+ood  21
+ood, 4
+ood. 4
+oods 3
+oof  2
+ook  15
+ooke 7
+ooki 4
+ooks 17
+ool  16
+ool, 2
+ool. 2
+ools 4
+oom  7
+oom, 3
+ooms 3
+oon  10
+oon. 4
+oons 2
+oop  2
+oop. 2
+oose 5
+ootb 4
+ooth 3
+oots 5
+op a 3
+op i 2
+op o 2
+op t 2
+op w 2
+op,  4
+op.  3
+ope  7
+ope, 2
+oped 2
+open 9
+oper 6
+opie 3
+ople 59
+oppi 2
+oppo 6
+ops  3
+ops, 2
+opul 3
+or a 37
+or b 3
+or c 10
+or d 3
+or e 9
+or f 5
+or g 3
+or h 6
+or i 5
+or l 2
+or m 16
+or n 4
+or o 3
+or p 4
+or r 2
+or s 12
+or t 28
+or w 9
+or y 2
+or,  3
+or.  4
+orag 2
+orat 2
+orce 2
+ord  16
+ord. 2
+orde 2
+ordi 4
+ords 10
+ore  61
+ore, 2
+ore. 4
+orec 6
+ored 3
+ores 4
+orga 4
+orge 3
+orie 7
+orin 2
+ork  22
+ork, 4
+orke 10
+orki 5
+orks 3
+orld 14
+orm  5
+orma 4
+orme 2
+orms 5
+orni 9
+ors  12
+ors, 6
+ort  13
+orta 20
+orte 3
+orth 11
+ortu 3
+orty 4
+orwa 4
+ory  13
+ory, 7
+ory. 3
+ose  14
+osen 3
+osin 6
+osit 5
+osph 3
+oss  10
+ossi 14
+ost  49
+osts 2
+ot a 8
+ot b 2
+ot c 3
+ot d 2
+ot e 3
+ot g 3
+ot h 4
+ot m 3
+ot n 4
+ot o 8
+ot p 2
+ot r 6
+ot s 6
+ot t 6
+ot u 2
+otat 2
+otba 4
+ote  7
+oteb 2
+otec 6
+otes 3
+oth  9
+othe 45
+othi 2
+otic 4
+otog 10
+ots  9
+ots, 2
+otte 2
+otto 2
+ou a 4
+ou c 5
+ou d 2
+ou f 3
+ou h 2
+ou t 2
+ough 34
+ould 45
+ounc 2
+ound 33
+oung 16
+ount 24
+oup  2
+our  32
+our, 3
+oura 2
+ourc 2
+ouri 3
+ourn 4
+ours 10
+ous  11
+ous. 2
+ousa 13
+ouse 6
+out  56
+out, 3
+out. 3
+outs 6
+ove  16
+oved 4
+ovel 2
+ovem 3
+oven 4
+over 38
+oves 9
+ovid 2
+ovin 2
+ow a 10
+ow b 4
+ow c 4
+ow e 2
+ow f 4
+ow h 2
+ow i 2
+ow l 2
+ow m 5
+ow o 5
+ow p 2
+ow s 4
+ow t 13
+ow v 2
+ow w 4
+ow,  3
+ow.  4
+owar 3
+owde 2
+owed 4
+ower 18
+owev 4
+owin 5
+owly 5
+own  34
+own, 6
+own. 9
+owne 4
+owns 3
+ownw 2
+ows  22
+ows, 2
+oxes 2
+oxid 2
+oxyg 2
+oyed 2
+p a  2
+p al 2
+p at 4
+p is 5
+p it 2
+p of 4
+p on 2
+p th 11
+p to 4
+p tr 2
+p wi 2
+p, t 2
+p, y 2
+p. a 2
+p. i 2
+p. t 4
+pace 7
+pack 3
+page 2
+paid 3
+pain 2
+pair 5
+pany 2
+pape 9
+para 2
+pare 9
+park 5
+part 15
+pass 11
+past 6
+path 3
+pati 7
+patt 2
+pay  3
+pdat 2
+pe i 3
+pe o 4
+pe t 3
+pe,  2
+pe.  3
+peak 5
+pear 10
+peat 3
+peci 3
+pect 5
+ped  15
+pen  7
+penc 2
+pend 15
+pene 3
+peni 2
+pens 3
+peop 57
+per  9
+per, 2
+per. 2
+pera 6
+perf 3
+peri 6
+pers 9
+peti 3
+ph i 3
+phab 9
+pher 11
+phon 13
+phot 10
+phs  2
+phs, 3
+phys 2
+pick 3
+pict 3
+piec 10
+pied 2
+pile 2
+pilo 3
+pils 2
+ping 9
+pion 2
+pite 3
+plac 19
+plai 2
+plan 41
+play 25
+ple  70
+ple, 5
+ple. 3
+plea 2
+ples 4
+plet 5
+plit 2
+ploy 3
+ply  11
+pmen 2
+pock 3
+poin 3
+poke 2
+pole 4
+poli 2
+poll 3
+pond 2
+pone 4
+popu 3
+port 23
+pose 2
+posi 5
+poss 14
+pota 2
+pots 4
+powe 6
+ppea 10
+pped 6
+ppen 4
+ppin 2
+pple 2
+ppon 4
+ppos 2
+pray 2
+prea 5
+pref 2
+prep 5
+pres 9
+pric 6
+prin 11
+pris 4
+prob 10
+proc 4
+prod 7
+prog 10
+prot 10
+prou 2
+prov 5
+ps a 2
+ps o 3
+ps t 10
+ps,  4
+pt s 2
+pt t 4
+pt,  2
+pted 5
+ptio 6
+pty  2
+publ 3
+pula 3
+pull 2
+pump 3
+pupi 2
+push 10
+put  5
+pute 17
+quar 5
+quee 3
+ques 6
+quic 7
+quie 7
+quip 3
+quir 5
+r a  39
+r ag 2
+r al 2
+r an 39
+r ar 2
+r as 4
+r at 4
+r aw 5
+r be 9
+r bo 6
+r bu 2
+r by 2
+r ca 5
+r ce 3
+r ch 9
+r cl 4
+r co 7
+r cu 2
+r de 3
+r do 4
+r en 3
+r ev 5
+r ex 3
+r fa 4
+r fi 6
+r fl 4
+r fo 6
+r fr 7
+r ga 3
+r go 3
+r gr 2
+r gu 2
+r ha 6
+r he 8
+r hi 3
+r ho 6
+r id 2
+r if 3
+r in 14
+r is 11
+r it 7
+r jo 2
+r la 4
+r le 6
+r li 5
+r lo 4
+r ma 10
+r me 5
+r mi 2
+r mo 12
+r ne 4
+r ni 2
+r no 5
+r of 17
+r on 8
+r op 3
+r or 2
+r ov 2
+r ow 8
+r pa 4
+r pe 3
+r ph 4
+r pl 2
+r po 2
+r pr 3
+r re 4
+r ri 2
+r ro 3
+r sa 5
+r sc 2
+r se 4
+r sh 3
+r si 7
+r sm 3
+r so 3
+r sp 3
+r st 13
+r ta 3
+r te 2
+r th 77
+r ti 2
+r to 18
+r tw 3
+r va 3
+r wa 5
+r we 6
+r wh 11
+r wi 10
+r wo 5
+r wr 2
+r, a 13
+r, b 4
+r, c 2
+r, f 5
+r, i 2
+r, s 4
+r, t 7
+r, w 10
+r. a 4
+r. f 6
+r. i 8
+r. o 3
+r. r 2
+r. s 2
+r. t 18
+r. w 3
+r. y 2
+rabl 3
+rack 3
+ract 2
+rade 10
+radi 2
+rage 5
+raig 2
+rail 2
+rain 31
+ral  13
+ram  3
+ramb 3
+ramm 5
+rams 3
+ran  2
+ranc 5
+rand 8
+rang 6
+rans 3
+rant 3
+raph 12
+rare 3
+rari 2
+rary 8
+rass 4
+rate 8
+rath 8
+rati 4
+rato 7
+rave 8
+rawe 3
+rbon 2
+rbor 3
+rbs  2
+rce  4
+rch  6
+rcha 2
+rche 2
+rd a 4
+rd c 2
+rd f 5
+rd i 3
+rd m 2
+rd o 2
+rd t 12
+rd,  4
+rd.  9
+rday 2
+rden 9
+rder 3
+rdin 7
+rds  15
+rds, 3
+rds. 2
+re a 32
+re b 7
+re c 11
+re d 6
+re e 4
+re f 8
+re h 4
+re i 29
+re l 7
+re m 5
+re n 9
+re o 13
+re p 13
+re r 7
+re s 23
+re t 46
+re u 3
+re w 11
+re,  8
+re.  8
+rea  2
+reac 5
+read 43
+reak 5
+real 8
+ream 13
+reas 6
+reat 15
+reca 6
+rece 6
+reco 6
+rect 6
+red  36
+red. 2
+redi 3
+reds 3
+redu 3
+ree  11
+reek 2
+reen 6
+rees 2
+reet 7
+refe 4
+refl 2
+refr 2
+refu 7
+regi 2
+regu 4
+rela 6
+rele 4
+reli 3
+rell 2
+rely 2
+rema 3
+reme 12
+ren  14
+ren, 2
+ren. 2
+renc 4
+reng 2
+rent 19
+repa 8
+repe 3
+repl 5
+repo 2
+requ 6
+res  5
+rese 5
+resh 4
+resp 3
+ress 12
+rest 12
+resu 2
+ret  7
+reti 3
+retu 4
+reve 3
+rew  5
+rewa 8
+rey  2
+rfac 4
+rfec 2
+rful 5
+rgan 4
+rge  10
+rger 5
+rget 2
+rgue 2
+rgum 2
+ria  3
+rian 2
+ribe 5
+rica 2
+rice 6
+rich 4
+ride 2
+ried 7
+rien 14
+ries 19
+rige 2
+righ 12
+rime 3
+ring 26
+rink 3
+rint 6
+riod 2
+riou 4
+rip  3
+ripe 3
+ript 2
+rise 12
+risi 5
+risk 3
+rist 3
+rite 9
+riti 9
+ritt 7
+rity 3
+rive 21
+rk a 6
+rk h 3
+rk i 2
+rk o 4
+rk s 2
+rk t 4
+rk w 3
+rk,  5
+rk.  2
+rkab 2
+rked 6
+rker 5
+rket 6
+rkin 5
+rks  5
+rks, 2
+rld  6
+rld. 7
+rly  12
+rly, 2
+rm k 2
+rm p 2
+rm,  2
+rmat 3
+rmed 2
+rmer 4
+rmin 4
+rms  7
+rms. 3
+rmy  2
+rn a 2
+rn c 3
+rn i 3
+rn o 2
+rn t 7
+rn,  3
+rned 7
+rner 2
+rney 3
+rnin 19
+rnme 4
+rnoo 8
+rns  4
+rns. 2
+road 5
+roba 2
+robl 8
+roce 4
+rock 5
+rodu 7
+roes 2
+rogr 10
+roke 4
+rol  2
+rom  56
+roma 2
+rong 12
+ront 5
+roof 3
+room 14
+root 3
+rope 5
+ropl 2
+rops 3
+rors 2
+ross 10
+rote 14
+roug 12
+roun 16
+roup 2
+rous 2
+rout 2
+rove 3
+rovi 2
+row  13
+row. 2
+rowd 2
+rown 4
+rows 4
+rpla 14
+rpri 5
+rran 2
+rren 3
+rrie 3
+rriv 7
+rror 3
+rrow 2
+rry  7
+rs a 19
+rs b 4
+rs c 5
+rs d 3
+rs f 4
+rs h 7
+rs i 13
+rs k 3
+rs m 4
+rs n 4
+rs o 16
+rs p 4
+rs r 3
+rs s 7
+rs t 17
+rs u 6
+rs w 12
+rs,  33
+rs.  21
+rsat 3
+rse, 2
+rson 6
+rst  31
+rst, 2
+rsta 7
+rt a 3
+rt b 4
+rt d 3
+rt h 2
+rt i 3
+rt o 11
+rt t 5
+rt w 5
+rt,  2
+rt.  2
+rtai 4
+rtan 19
+rted 9
+rter 2
+rth  12
+rth. 3
+rthe 3
+rtin 4
+rtly 2
+rtme 4
+rts  3
+rtun 3
+rty  6
+ruct 4
+ruit 5
+rule 15
+run  2
+runn 3
+rush 4
+rust 7
+rve  2
+rved 2
+rves 5
+rvey 2
+rvic 3
+rviv 3
+rwar 4
+ry a 10
+ry b 3
+ry c 4
+ry d 14
+ry f 5
+ry h 7
+ry i 10
+ry l 3
+ry m 3
+ry n 4
+ry o 11
+ry p 5
+ry q 2
+ry t 12
+ry u 2
+ry w 4
+ry,  14
+ry.  6
+ryin 5
+ryon 10
+rypt 8
+ryth 5
+s a  51
+s ab 10
+s ac 2
+s ad 5
+s ag 7
+s ah 2
+s al 19
+s an 90
+s ap 3
+s ar 43
+s as 4
+s at 10
+s ba 6
+s be 29
+s bl 2
+s br 3
+s bu 2
+s by 3
+s ca 21
+s ch 10
+s cl 6
+s co 16
+s cu 4
+s de 9
+s di 7
+s do 6
+s dr 4
+s du 2
+s ea 5
+s en 5
+s ev 7
+s fa 7
+s fi 2
+s fl 2
+s fo 19
+s fr 12
+s fu 2
+s ga 2
+s gi 2
+s go 3
+s gr 8
+s ha 22
+s he 7
+s hi 4
+s ho 5
+s hu 2
+s if 3
+s im 7
+s in 53
+s is 18
+s it 11
+s jo 2
+s ju 3
+s ki 4
+s kn 3
+s la 7
+s le 6
+s li 9
+s lo 9
+s ma 10
+s me 2
+s mi 3
+s mo 12
+s mu 7
+s ne 13
+s ni 2
+s no 31
+s nu 2
+s of 81
+s ol 2
+s on 35
+s or 6
+s ou 2
+s ov 3
+s ow 2
+s pa 7
+s pe 4
+s pl 6
+s po 7
+s pr 7
+s pu 3
+s qu 2
+s ra 5
+s re 15
+s ri 2
+s ru 2
+s sa 6
+s sc 2
+s se 6
+s sh 10
+s si 6
+s sl 4
+s sm 5
+s so 14
+s sp 2
+s st 27
+s su 9
+s ta 11
+s th 124
+s ti 5
+s to 60
+s tr 9
+s tw 3
+s un 6
+s up 2
+s us 17
+s va 3
+s ve 5
+s wa 10
+s we 31
+s wh 20
+s wi 20
+s wo 18
+s wr 2
+s yo 2
+s, a 50
+s, b 18
+s, c 5
+s, d 2
+s, e 6
+s, f 7
+s, i 2
+s, l 6
+s, m 4
+s, o 5
+s, p 6
+s, s 13
+s, t 27
+s, u 2
+s, w 14
+s. a 14
+s. b 2
+s. c 2
+s. d 3
+s. e 3
+s. f 7
+s. g 2
+s. h 2
+s. i 9
+s. l 2
+s. m 5
+s. o 11
+s. p 8
+s. r 2
+s. s 6
+s. t 27
+s. w 13
+s. y 2
+s: a 3
+safe 6
+sage 19
+said 6
+sala 3
+salt 19
+same 21
+sand 17
+sapp 3
+sat  2
+sati 3
+satu 4
+save 3
+say  4
+says 3
+scen 2
+scho 13
+scie 5
+scle 2
+scop 3
+scov 5
+scra 5
+scre 5
+scri 7
+scui 2
+scus 2
+se a 13
+se b 3
+se c 5
+se f 2
+se i 10
+se m 3
+se n 2
+se o 5
+se p 3
+se s 9
+se t 25
+se v 2
+se w 4
+se,  8
+se.  10
+sea  6
+sea, 2
+sear 4
+seas 9
+seco 6
+secr 8
+secu 2
+sed  25
+sed. 2
+see  13
+seed 4
+seem 14
+seen 4
+sefu 4
+self 5
+sell 4
+selv 3
+sen  3
+send 10
+sent 3
+seri 3
+sert 3
+serv 8
+ses  19
+ses, 5
+ses. 2
+set  4
+sett 2
+seve 15
+sful 4
+sh a 4
+sh i 2
+sh o 3
+sh,  3
+sh.  2
+shap 8
+shar 2
+shch 2
+she  24
+shed 6
+shee 5
+shel 5
+shes 6
+shif 7
+shin 7
+ship 3
+shon 2
+shop 7
+shor 11
+shou 5
+show 11
+sibl 15
+sic  6
+side 23
+sier 5
+sigh 3
+sign 14
+silv 2
+simi 3
+simp 27
+sinc 3
+sine 7
+sing 25
+sion 5
+sist 5
+sit  5
+site 8
+siti 4
+sive 4
+sixt 2
+size 4
+sk h 2
+sk t 3
+sk.  2
+skin 2
+sky  10
+slee 15
+slow 13
+smal 41
+smel 3
+smit 2
+smok 2
+smoo 3
+snow 2
+so a 3
+so d 2
+so e 2
+so h 2
+so i 4
+so m 3
+so o 2
+so p 4
+so s 2
+so t 20
+so w 2
+soft 3
+soil 9
+sold 3
+solu 2
+solv 3
+some 51
+son  7
+son, 4
+sons 2
+soon 8
+soun 16
+sour 2
+spac 7
+spap 4
+spea 5
+spec 6
+spen 10
+sphe 3
+spit 2
+spli 2
+spok 2
+spot 2
+spre 5
+spri 5
+squa 5
+ss a 5
+ss c 3
+ss d 2
+ss e 2
+ss i 9
+ss m 2
+ss o 3
+ss t 8
+ss u 3
+ss w 4
+ss,  7
+ss.  8
+ssag 19
+ssar 2
+ssed 5
+sses 8
+ssfu 4
+ssib 14
+ssin 2
+ssio 2
+sswo 5
+st a 12
+st b 4
+st c 10
+st d 4
+st e 6
+st f 9
+st g 2
+st i 17
+st l 6
+st m 3
+st o 12
+st p 6
+st r 5
+st s 8
+st t 23
+st w 10
+st y 2
+st,  11
+st.  9
+stag 3
+stak 6
+stal 4
+stan 20
+star 27
+stat 7
+stau 2
+stay 6
+ste  4
+stea 10
+stem 9
+sten 6
+step 7
+ster 9
+stes 2
+stic 4
+stil 20
+stin 4
+stio 6
+stom 7
+ston 3
+stop 7
+stor 35
+stra 7
+stre 17
+stri 3
+stro 10
+stru 5
+sts  20
+sts. 6
+stud 13
+stur 3
+sty  2
+sual 6
+succ 5
+such 6
+sudd 3
+suga 2
+sugg 3
+suit 2
+sult 2
+summ 8
+sun  6
+sun, 2
+supp 2
+sura 2
+sure 5
+surf 4
+surp 4
+surv 5
+susp 2
+swea 2
+swer 3
+swim 3
+swit 2
+swor 5
+sy t 5
+symb 3
+syst 9
+t a  25
+t ac 3
+t af 2
+t al 13
+t an 29
+t ap 3
+t ar 7
+t as 7
+t at 5
+t ba 4
+t be 13
+t bo 2
+t br 6
+t bu 3
+t by 2
+t ca 20
+t ch 4
+t ci 2
+t cl 3
+t co 18
+t cu 3
+t da 4
+t de 11
+t di 8
+t do 4
+t dr 2
+t ea 7
+t en 6
+t ev 11
+t ex 6
+t fa 4
+t fe 7
+t fi 6
+t fo 18
+t fr 4
+t ga 3
+t gi 5
+t go 4
+t gr 2
+t ha 13
+t he 12
+t hi 2
+t ho 7
+t im 11
+t in 32
+t is 66
+t it 28
+t ke 2
+t kn 2
+t le 14
+t li 3
+t lo 7
+t lu 2
+t ma 9
+t me 7
+t mi 2
+t mo 9
+t ne 7
+t ni 2
+t no 8
+t of 44
+t on 15
+t op 3
+t or 6
+t ou 2
+t ov 2
+t pa 5
+t pe 4
+t pl 6
+t po 7
+t pr 4
+t pu 2
+t qu 2
+t ra 5
+t re 14
+t ri 4
+t ru 5
+t sc 3
+t se 12
+t sh 11
+t si 6
+t sk 3
+t sm 3
+t so 8
+t sp 6
+t st 17
+t su 8
+t ta 7
+t te 4
+t th 125
+t ti 2
+t to 40
+t tr 6
+t tu 2
+t tw 3
+t un 2
+t us 8
+t wa 26
+t we 14
+t wh 12
+t wi 9
+t wo 5
+t wr 2
+t ye 5
+t yo 7
+t's  3
+t, a 18
+t, b 4
+t, c 2
+t, e 4
+t, f 2
+t, h 3
+t, i 5
+t, m 5
+t, s 3
+t, t 10
+t, w 5
+t. a 6
+t. b 5
+t. h 3
+t. i 9
+t. m 3
+t. s 2
+t. t 18
+t. w 7
+t. y 2
+ta.  2
+tabl 18
+tack 7
+tage 7
+tail 4
+tain 13
+take 28
+tal. 2
+talk 13
+tall 6
+tanc 5
+tand 12
+tant 22
+tar  2
+tars 7
+tart 18
+task 3
+tast 3
+tati 7
+taur 2
+taxe 3
+tay  6
+tbal 4
+tch  10
+tche 9
+tchi 4
+te b 2
+te d 2
+te i 7
+te l 4
+te o 4
+te p 2
+te r 2
+te s 2
+te t 11
+te w 3
+te,  6
+te.  5
+teac 11
+tead 9
+team 5
+tebo 2
+tect 11
+ted  35
+ted, 3
+ted. 8
+teen 7
+teer 4
+tele 8
+tell 10
+tely 6
+tem  5
+temp 3
+tems 4
+ten  40
+ten, 4
+tenc 2
+teni 3
+tens 2
+tent 6
+tep  5
+ter  74
+ter, 13
+ter. 9
+tere 2
+tern 11
+ters 33
+tes  9
+tes, 6
+test 8
+teve 4
+text 3
+th a 26
+th c 12
+th d 3
+th e 6
+th f 2
+th h 6
+th i 6
+th m 5
+th o 9
+th p 7
+th r 3
+th s 6
+th t 21
+th w 2
+th,  5
+th.  7
+than 45
+that 160
+the  950
+thei 47
+them 29
+then 19
+theo 2
+ther 116
+thes 14
+they 76
+thic 2
+thin 36
+thir 3
+this 21
+thod 7
+thom 3
+thos 4
+thou 30
+thre 4
+thro 10
+ths  5
+tic  2
+tic, 3
+tica 3
+tice 4
+tick 3
+tics 5
+tien 7
+ties 10
+tiet 3
+til  3
+till 20
+time 45
+ting 48
+tinu 2
+tiny 2
+tion 62
+tire 7
+tist 7
+titi 2
+tive 11
+tle  11
+tly  12
+tly, 2
+tmen 4
+tmos 3
+tnes 2
+to a 31
+to b 18
+to c 30
+to d 9
+to e 4
+to f 12
+to g 10
+to h 9
+to i 2
+to j 3
+to k 10
+to l 18
+to m 8
+to n 3
+to o 2
+to p 14
+to r 28
+to s 39
+to t 61
+to u 8
+to w 16
+to y 2
+toda 12
+toes 2
+toge 7
+togr 11
+told 3
+tom, 2
+toma 7
+tome 7
+tone 3
+too  9
+took 3
+tool 5
+top  6
+topp 2
+tor  4
+tore 3
+tori 7
+torm 4
+tors 12
+tory 18
+tour 4
+towa 3
+towe 3
+town 14
+tra  3
+trac 3
+trad 10
+trai 11
+tral 2
+tran 9
+trap 2
+trat 3
+trav 8
+trea 7
+tree 8
+tren 2
+tres 2
+tric 4
+trie 8
+trip 5
+trol 3
+tron 10
+truc 4
+true 3
+trus 2
+try  6
+try, 2
+tryi 4
+ts a 25
+ts b 2
+ts c 7
+ts d 3
+ts e 2
+ts f 7
+ts g 4
+ts h 4
+ts i 6
+ts m 2
+ts n 3
+ts o 15
+ts s 6
+ts t 26
+ts u 2
+ts w 9
+ts,  14
+ts.  14
+tsel 4
+tsid 5
+ttac 7
+tten 11
+tter 41
+ttin 8
+ttle 12
+ttom 2
+tube 2
+tude 6
+tudi 2
+tudy 5
+tuna 2
+turd 3
+ture 9
+turi 4
+turn 21
+tury 8
+twee 10
+twen 6
+twic 3
+two  22
+twor 4
+ty a 2
+ty b 2
+ty c 2
+ty f 2
+ty i 2
+ty m 2
+ty o 6
+ty s 2
+ty y 5
+ty,  3
+ty.  4
+type 2
+u ar 2
+u ca 5
+u do 2
+u fo 2
+u ha 2
+uage 13
+ual  2
+uall 5
+uare 5
+ubli 3
+ucce 5
+uce  6
+uces 3
+uch  30
+uch. 2
+uck  2
+ucti 3
+ud,  2
+udde 3
+uden 6
+udge 2
+udie 2
+udin 4
+udy  5
+ue t 2
+ue w 2
+ue,  2
+ue.  2
+ueen 3
+uel  3
+uenc 2
+ues  2
+uest 9
+ugar 2
+ugge 3
+ugh  28
+ugh, 4
+ugh. 2
+ught 6
+uick 7
+uiet 7
+uild 11
+uilt 6
+uipm 2
+uire 5
+uit  7
+uits 2
+ul c 2
+ul d 2
+ul o 2
+ul p 4
+ul t 2
+ul,  2
+ul.  3
+ular 9
+ulat 3
+uld  45
+ule  6
+ules 8
+ull  5
+ulls 2
+ult  6
+ult. 2
+ulti 3
+ults 5
+uman 6
+umbe 17
+umbr 2
+umen 2
+umme 8
+umn  2
+ump  2
+un a 3
+un c 2
+un,  2
+unat 2
+unch 4
+unci 2
+und  23
+und. 3
+unde 17
+undr 7
+unds 6
+unfo 3
+ung  12
+unge 4
+unic 2
+unit 4
+unne 2
+unni 3
+unt  3
+unt, 2
+unta 4
+unte 4
+unti 7
+untr 10
+up a 5
+up o 4
+up t 3
+up.  2
+upda 2
+upil 2
+upit 2
+ur a 4
+ur b 2
+ur c 3
+ur f 2
+ur g 2
+ur l 4
+ur o 3
+ur p 3
+ur s 3
+ur t 3
+ur,  3
+urag 2
+uran 4
+urce 2
+urch 3
+urda 2
+ure  9
+urem 2
+ures 3
+urfa 4
+urie 4
+urin 8
+uris 3
+urit 3
+urn  10
+urn, 2
+urne 7
+urni 5
+urns 4
+urop 4
+urpr 4
+urre 3
+urs  5
+urs. 3
+urse 2
+urth 2
+urve 4
+urvi 3
+ury  4
+ury, 4
+us a 7
+us d 2
+us f 4
+us i 2
+us m 2
+us o 2
+us w 2
+us,  3
+us.  2
+usan 13
+uscl 2
+use  44
+use. 4
+used 21
+usef 4
+uses 8
+ush  4
+ushc 2
+ushe 3
+ushi 6
+usic 2
+usin 9
+usio 2
+ust  11
+ust, 2
+usto 7
+usty 2
+usua 6
+ut a 18
+ut b 3
+ut f 2
+ut g 2
+ut h 3
+ut i 18
+ut l 2
+ut m 2
+ut o 8
+ut s 6
+ut t 31
+ut w 7
+ut,  4
+ut.  3
+ute  4
+uten 4
+uter 17
+utes 6
+utom 5
+utri 2
+uts  3
+utsi 5
+utti 3
+vall 3
+valu 4
+valv 2
+vanc 2
+vant 4
+vapo 2
+ve a 15
+ve b 10
+ve c 4
+ve d 4
+ve f 4
+ve g 3
+ve i 7
+ve l 2
+ve m 6
+ve n 3
+ve o 3
+ve r 4
+ve s 8
+ve t 28
+ve u 2
+ve w 3
+ve,  4
+ve.  3
+ved  11
+vege 6
+vel  3
+vel, 2
+vele 2
+veli 2
+velo 4
+veme 4
+ven  17
+ven. 2
+veni 13
+vent 13
+ver  50
+ver, 4
+ver. 4
+vera 13
+vere 7
+veri 2
+vern 5
+vers 10
+very 71
+ves  40
+ves, 2
+ves. 6
+vest 3
+vey  2
+vice 7
+vide 7
+view 3
+vill 9
+ving 11
+vior 6
+visi 3
+vive 3
+voic 4
+void 3
+volu 4
+w ab 3
+w an 5
+w ba 2
+w be 5
+w ca 3
+w co 2
+w da 3
+w ea 2
+w fr 2
+w ha 2
+w ho 3
+w in 3
+w it 3
+w la 2
+w ma 3
+w mi 2
+w mu 2
+w of 2
+w or 2
+w pe 2
+w po 2
+w ri 2
+w sh 3
+w th 11
+w to 4
+w wh 3
+w ye 2
+w, a 3
+wait 7
+walk 9
+wall 7
+want 7
+ward 18
+warm 11
+was  50
+wast 2
+watc 8
+wate 25
+way  23
+way, 6
+ways 14
+wcom 2
+wded 2
+we a 4
+we b 2
+we c 4
+we d 3
+we h 5
+we m 2
+we r 4
+we s 4
+we t 2
+we w 2
+weak 5
+weat 13
+webs 4
+wed  3
+week 10
+ween 10
+weig 5
+well 8
+went 9
+wer  8
+wer. 2
+were 32
+werf 4
+wers 8
+wet  4
+weve 4
+what 28
+when 44
+wher 21
+whet 5
+whic 17
+whil 12
+whit 2
+who  43
+whol 14
+why  8
+wice 3
+wide 2
+will 12
+wimm 2
+wind 16
+wing 10
+wint 7
+wire 4
+wise 3
+witc 2
+with 87
+witn 2
+wly  5
+wn a 4
+wn b 3
+wn c 2
+wn h 3
+wn i 4
+wn o 2
+wn p 2
+wn t 5
+wn u 2
+wn,  7
+wn.  9
+wner 4
+wnin 4
+wns  3
+wnwa 2
+wo c 3
+wo m 3
+wo p 2
+wo s 2
+wo t 3
+woma 7
+wond 2
+word 25
+work 44
+worl 14
+worr 2
+wort 7
+woul 15
+writ 24
+wron 4
+wrot 4
+ws a 3
+ws d 2
+ws e 2
+ws h 2
+ws i 4
+ws o 4
+ws t 4
+ws w 2
+ws,  2
+wspa 4
+xact 4
+xamp 2
+xcep 3
+xcha 5
+xcit 2
+xed  2
+xes  4
+xide 2
+xing 2
+xist 3
+xpec 2
+xpen 3
+xper 5
+xpla 2
+xt s 3
+xt t 3
+xt w 2
+xtra 3
+xyge 2
+y a  17
+y ab 5
+y ac 5
+y ad 2
+y af 4
+y ag 2
+y ah 2
+y al 5
+y an 12
+y ar 7
+y as 6
+y at 3
+y ba 3
+y be 11
+y bi 3
+y bo 3
+y bu 3
+y by 5
+y ca 10
+y ce 3
+y ch 2
+y cl 2
+y co 16
+y da 13
+y de 2
+y di 6
+y do 4
+y dr 3
+y ev 6
+y ex 3
+y fe 3
+y fi 6
+y fo 3
+y fr 2
+y gr 5
+y ha 22
+y he 4
+y ho 3
+y if 2
+y im 2
+y in 11
+y is 15
+y it 12
+y ki 2
+y kn 6
+y la 3
+y le 5
+y li 7
+y lo 3
+y ma 5
+y me 2
+y mi 3
+y mo 5
+y mu 5
+y ne 5
+y ni 4
+y no 2
+y ob 2
+y of 21
+y on 14
+y or 7
+y ou 2
+y pa 4
+y pe 8
+y pi 2
+y pl 2
+y po 3
+y pr 2
+y pu 2
+y qu 2
+y ra 2
+y re 6
+y ri 3
+y ru 2
+y sa 5
+y se 8
+y sh 2
+y si 2
+y sl 2
+y so 8
+y sp 2
+y st 6
+y su 2
+y ta 2
+y te 3
+y th 43
+y ti 5
+y to 27
+y tr 3
+y tw 5
+y us 4
+y wa 8
+y we 6
+y wh 7
+y wi 6
+y wo 5
+y wr 3
+y ye 6
+y yo 3
+y, a 17
+y, b 7
+y, i 5
+y, l 2
+y, m 3
+y, o 2
+y, r 3
+y, s 7
+y, t 10
+y, w 6
+y. a 5
+y. e 3
+y. i 6
+y. l 3
+y. m 2
+y. o 3
+y. s 4
+y. t 5
+y. w 2
+y? w 2
+ycle 7
+ycli 2
+year 27
+yeas 4
+yed  7
+yell 4
+yer  4
+yer. 2
+yers 7
+yet  13
+ygen 2
+ying 9
+ymbo 3
+yone 17
+you  25
+youn 16
+your 10
+ype  2
+ypte 3
+ypti 3
+ys a 9
+ys e 3
+ys t 5
+ys,  3
+ys.  4
+ysic 2
+ysis 2
+yste 9
+ythi 6
+ywhe 2
+ywor 6
+ze o 2
+ze t 2
+zed  2
+`
@@ -0,0 +1,102 @@
+//go:build ignore
+// +build ignore
+
+// This program generates english_ngrams.go from the modern English prose
+// of fixtures/english_corpus.txt.
+// Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+)
+
+// minCount is the number of times a n-gram needs to be seen to be kept in the table,
+// rare n-grams are dropped to keep the generated file small.
+var minCount = map[int]int{3: 1, 4: 2}
+
+func main() {
+	corpus, err := ioutil.ReadFile(filepath.Join("fixtures", "english_corpus.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	seq := symbols(corpus)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by gen_ngrams.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package kripto")
+	for _, n := range []int{3, 4} {
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "// english%s lists the count of each %d-gram seen in the reference English text.\n", tableName(n), n)
+		fmt.Fprintf(buf, "const english%s = `\n", tableName(n))
+		for _, line := range table(seq, n) {
+			fmt.Fprintln(buf, line)
+		}
+		fmt.Fprintln(buf, "`")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("english_ngrams.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func tableName(n int) string {
+	if n == 3 {
+		return "Trigrams"
+	}
+	return "Quadgrams"
+}
+
+// symbols applies the same normalization as the kripto n-gram scorer
+// and collapses consecutive white spaces.
+func symbols(data []byte) []byte {
+	seq := make([]byte, 0, len(data))
+	for _, b := range data {
+		switch {
+		case b >= 'A' && b <= 'Z':
+			b += 'a' - 'A'
+		case b == 9 || b == 10 || b == 12 || b == 13:
+			b = ' '
+		case b >= 32 && b <= 126:
+		default:
+			// non ASCII bytes aren't part of any n-gram
+			b = 0
+		}
+		// the line breaks and indentation are layout, not language
+		if b == ' ' && len(seq) > 0 && seq[len(seq)-1] == ' ' {
+			continue
+		}
+		seq = append(seq, b)
+	}
+	return seq
+}
+
+func table(seq []byte, n int) []string {
+	counts := map[string]int{}
+	for i := 0; i+n <= len(seq); i++ {
+		gram := seq[i : i+n]
+		if bytes.IndexByte(gram, 0) >= 0 {
+			continue
+		}
+		counts[string(gram)]++
+	}
+	lines := []string{}
+	for gram, count := range counts {
+		if count < minCount[n] {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %d", gram, count))
+	}
+	sort.Strings(lines)
+	return lines
+}
@@ -0,0 +1,113 @@
+package kripto
+
+import "sort"
+
+// KeySizeCandidate is a possible length for a repeating key
+// and the score the analysis gave it (higher is more likely).
+type KeySizeCandidate struct {
+	Size  int
+	Score float64
+}
+
+// KeySizeCandidates is a collection of key size candidates.
+type KeySizeCandidates []*KeySizeCandidate
+
+// Len implements the sort interface
+func (c KeySizeCandidates) Len() int {
+	return len(c)
+}
+
+// Swap implements the sort interface
+func (c KeySizeCandidates) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
+
+// Less implements the sort interface, best scores first and smaller sizes first on ties.
+func (c KeySizeCandidates) Less(i, j int) bool {
+	if c[i].Score == c[j].Score {
+		return c[i].Size < c[j].Size
+	}
+	return c[i].Score > c[j].Score
+}
+
+// Sizes returns the candidate key sizes in the collection order.
+func (c KeySizeCandidates) Sizes() []int {
+	sizes := make([]int, len(c))
+	for i, candidate := range c {
+		sizes[i] = candidate.Size
+	}
+	return sizes
+}
+
+// KasiskiKeySizes uses the Kasiski examination to find the likely lengths of the key
+// used to encrypt data with a repeating key (see MultiCharXor).
+// The ciphertext is scanned for repeated sequences of nGramLen bytes, the same plaintext
+// encrypted at the same key offset produces the same ciphertext so the distance between
+// repetitions is usually a multiple of the key length.
+// The GCD of the distances between the occurrences of each repeated sequence is collected
+// (a sequence longer than nGramLen counts once) and each key size between 2 and maxKeySize
+// is scored by how many of those GCDs it divides, minus the ratio expected by chance (1/size).
+// The key size can't be told apart from the divisors of the distances it divides when there are
+// only a few repetitions, the largest of them is then ranked first.
+// Candidates are returned best first, key sizes that don't divide any distance are omitted.
+func KasiskiKeySizes(data []byte, nGramLen, maxKeySize int) KeySizeCandidates {
+	if nGramLen < 2 {
+		nGramLen = 2
+	}
+	candidates := KeySizeCandidates{}
+	if len(data) < nGramLen*2 {
+		return candidates
+	}
+
+	positions := map[string][]int{}
+	for i := 0; i+nGramLen <= len(data); i++ {
+		nGram := string(data[i : i+nGramLen])
+		positions[nGram] = append(positions[nGram], i)
+	}
+
+	spacings := []int{}
+	for _, pos := range positions {
+		g := 0
+		for i := 1; i < len(pos); i++ {
+			p, q := pos[i-1], pos[i]
+			// a repeated sequence longer than nGramLen is only counted once, at its first n-gram,
+			// otherwise a single repeated word would outweigh all the other repetitions
+			if p > 0 && data[p-1] == data[q-1] {
+				continue
+			}
+			g = gcd(g, q-p)
+		}
+		if g > 0 {
+			spacings = append(spacings, g)
+		}
+	}
+	if len(spacings) == 0 {
+		return candidates
+	}
+
+	for size := 2; size <= maxKeySize; size++ {
+		var divisible int
+		for _, s := range spacings {
+			if s%size == 0 {
+				divisible++
+			}
+		}
+		if divisible == 0 {
+			continue
+		}
+		candidates = append(candidates, &KeySizeCandidate{
+			Size:  size,
+			Score: float64(divisible)/float64(len(spacings)) - 1/float64(size),
+		})
+	}
+	sort.Sort(candidates)
+	return candidates
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,66 @@
+package kripto
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestKasiskiKeySizes(t *testing.T) {
+	sixTxt, err := ioutil.ReadFile(fixturePath("6.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		input    []byte
+		nGramLen int
+		best     int
+		expected []int
+	}{
+		{[]byte{
+			// crypto
+			0x2, 0x10, 0x1a, 0x14, 0x15, 0xd,
+			// isshortfor
+			0xa, 0x17, 0x12, 0xa, 0xc, 0x16, 0x15, 0x4, 0xc, 0x16,
+			// crypto
+			0x2, 0x10, 0x1a, 0x14, 0x15, 0xd,
+			// graphy
+			0x4, 0x16, 0x0, 0x12, 0xb, 0x1d},
+			// "crypto" repeats 16 bytes apart and "or" 4 bytes apart
+			2,
+			4,
+			[]int{4, 2, 16, 8},
+		},
+		{DeBase64(sixTxt), 3, 29, nil},
+		{DeBase64(sixTxt), 5, 29, nil},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		candidates := KasiskiKeySizes(tc.input, tc.nGramLen, 40)
+		if len(candidates) == 0 {
+			t.Fatal("expected key size candidates")
+		}
+		if candidates[0].Size != tc.best {
+			t.Fatalf("expected best key size to be %d\ngot\n%v\n", tc.best, candidates.Sizes())
+		}
+		if tc.expected == nil {
+			continue
+		}
+		sizes := candidates.Sizes()
+		if len(sizes) != len(tc.expected) {
+			t.Fatalf("expected %v\ngot\n%v\n", tc.expected, sizes)
+		}
+		for j, size := range tc.expected {
+			if sizes[j] != size {
+				t.Fatalf("expected %v\ngot\n%v\n", tc.expected, sizes)
+			}
+		}
+	}
+}
+
+func TestKasiskiKeySizesNoRepetition(t *testing.T) {
+	if candidates := KasiskiKeySizes([]byte("abcdefghijklmnop"), 3, 40); len(candidates) != 0 {
+		t.Fatalf("expected no candidates, got %v", candidates.Sizes())
+	}
+}
@@ -34,3 +34,69 @@ func (stats ByteKeyColStats) String() string {
 	}
 	return o
 }
+
+// MultiByteKeyStats tracks the stats of a repeating multiple character encoding key
+type MultiByteKeyStats struct {
+	CharMap *CharUseMap
+	Score   float64
+	Text    []byte
+	Key     []byte
+	KeySize int
+}
+
+type MultiByteKeyColStats []*MultiByteKeyStats
+
+// Len implements the sort interface
+func (s MultiByteKeyColStats) Len() int {
+	return len(s)
+}
+
+// Swap implements the sort interface
+func (s MultiByteKeyColStats) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Less implements the sort interface
+func (s MultiByteKeyColStats) Less(i, j int) bool {
+	return s[i].Score > s[j].Score
+}
+
+func (stats MultiByteKeyColStats) String() string {
+	var o string
+	for _, s := range stats {
+		o += fmt.Sprintf("%d: %q -> %0.2f -> %s\n", s.KeySize, s.Key, s.Score, string(s.Text))
+	}
+	return o
+}
+
+// LineKeyStats tracks the stats of the most likely single character key of a line
+type LineKeyStats struct {
+	*ByteKeyStats
+	// Line is the index of the line in the analyzed input
+	Line int
+}
+
+type LineKeyColStats []*LineKeyStats
+
+// Len implements the sort interface
+func (s LineKeyColStats) Len() int {
+	return len(s)
+}
+
+// Swap implements the sort interface
+func (s LineKeyColStats) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Less implements the sort interface
+func (s LineKeyColStats) Less(i, j int) bool {
+	return s[i].Score > s[j].Score
+}
+
+func (stats LineKeyColStats) String() string {
+	var o string
+	for _, s := range stats {
+		o += fmt.Sprintf("%d: %s -> %0.2f -> %s\n", s.Line, string(s.Key), s.Score, string(s.Text))
+	}
+	return o
+}
@@ -0,0 +1,190 @@
+package kripto
+
+import (
+	"fmt"
+	"math"
+	"unicode/utf8"
+)
+
+// EnglishProfile is the built-in profile of the English letter frequencies (see EnglishLetterFreqs)
+var EnglishProfile = newLetterProfile("English", EnglishLetterFreqs)
+
+// The letter frequencies of the following languages come from
+// https://en.wikipedia.org/wiki/Letter_frequency#Relative_frequencies_of_letters_in_other_languages
+// Since the char maps work on bytes, the accented characters are UTF-8 encoded:
+// each of their bytes is part of the distribution (so all the accented latin letters share the 0xC3 byte).
+
+// FrenchProfile is the built-in profile of the French letter frequencies
+var FrenchProfile = newLetterProfile("French", letterFreqs(map[rune]float64{
+	'a': 7.636, 'b': 0.901, 'c': 3.260, 'd': 3.669, 'e': 14.715, 'f': 1.066, 'g': 0.866,
+	'h': 0.737, 'i': 7.529, 'j': 0.613, 'k': 0.074, 'l': 5.456, 'm': 2.968, 'n': 7.095,
+	'o': 5.796, 'p': 2.521, 'q': 1.362, 'r': 6.693, 's': 7.948, 't': 7.244, 'u': 6.311,
+	'v': 1.838, 'w': 0.049, 'x': 0.427, 'y': 0.128, 'z': 0.326,
+	'à': 0.486, 'â': 0.051, 'œ': 0.018, 'ç': 0.085, 'è': 0.271, 'é': 1.504, 'ê': 0.218,
+	'ë': 0.008, 'î': 0.045, 'ï': 0.005, 'ô': 0.023, 'ù': 0.058, 'û': 0.060,
+}))
+
+// GermanProfile is the built-in profile of the German letter frequencies
+var GermanProfile = newLetterProfile("German", letterFreqs(map[rune]float64{
+	'a': 6.516, 'b': 1.886, 'c': 2.732, 'd': 5.076, 'e': 16.396, 'f': 1.656, 'g': 3.009,
+	'h': 4.577, 'i': 6.550, 'j': 0.268, 'k': 1.417, 'l': 3.437, 'm': 2.534, 'n': 9.776,
+	'o': 2.594, 'p': 0.670, 'q': 0.018, 'r': 7.003, 's': 7.270, 't': 6.154, 'u': 4.166,
+	'v': 0.846, 'w': 1.921, 'x': 0.034, 'y': 0.039, 'z': 1.134,
+	'ä': 0.578, 'ö': 0.443, 'ü': 0.995, 'ß': 0.307,
+}))
+
+// SpanishProfile is the built-in profile of the Spanish letter frequencies
+var SpanishProfile = newLetterProfile("Spanish", letterFreqs(map[rune]float64{
+	'a': 11.525, 'b': 2.215, 'c': 4.019, 'd': 5.010, 'e': 12.181, 'f': 0.692, 'g': 1.768,
+	'h': 0.703, 'i': 6.247, 'j': 0.493, 'k': 0.011, 'l': 4.967, 'm': 3.157, 'n': 6.712,
+	'o': 8.683, 'p': 2.510, 'q': 0.877, 'r': 6.871, 's': 7.977, 't': 4.632, 'u': 2.927,
+	'v': 1.138, 'w': 0.017, 'x': 0.215, 'y': 1.008, 'z': 0.467,
+	'á': 0.502, 'é': 0.433, 'í': 0.725, 'ñ': 0.311, 'ó': 0.827, 'ú': 0.168, 'ü': 0.012,
+}))
+
+// ItalianProfile is the built-in profile of the Italian letter frequencies
+var ItalianProfile = newLetterProfile("Italian", letterFreqs(map[rune]float64{
+	'a': 11.745, 'b': 0.927, 'c': 4.501, 'd': 3.736, 'e': 11.792, 'f': 1.153, 'g': 1.644,
+	'h': 0.636, 'i': 10.143, 'j': 0.011, 'k': 0.009, 'l': 6.510, 'm': 2.512, 'n': 6.883,
+	'o': 9.832, 'p': 3.056, 'q': 0.505, 'r': 6.367, 's': 4.981, 't': 5.623, 'u': 3.011,
+	'v': 2.097, 'w': 0.033, 'x': 0.003, 'y': 0.020, 'z': 1.181,
+	'à': 0.635, 'è': 0.263, 'ì': 0.030, 'ò': 0.002, 'ù': 0.166,
+}))
+
+// PortugueseProfile is the built-in profile of the Portuguese letter frequencies
+var PortugueseProfile = newLetterProfile("Portuguese", letterFreqs(map[rune]float64{
+	'a': 14.634, 'b': 1.043, 'c': 3.882, 'd': 4.992, 'e': 12.570, 'f': 1.023, 'g': 1.303,
+	'h': 0.781, 'i': 6.186, 'j': 0.397, 'k': 0.015, 'l': 2.779, 'm': 4.738, 'n': 4.446,
+	'o': 9.735, 'p': 2.523, 'q': 1.204, 'r': 6.530, 's': 6.805, 't': 4.336, 'u': 3.639,
+	'v': 1.575, 'w': 0.037, 'x': 0.253, 'y': 0.006, 'z': 0.470,
+	'á': 0.118, 'â': 0.562, 'ã': 0.733, 'à': 0.072, 'ç': 0.530, 'é': 0.337, 'ê': 0.450,
+	'í': 0.132, 'ó': 0.296, 'ô': 0.635, 'õ': 0.040, 'ú': 0.207,
+}))
+
+// DutchProfile is the built-in profile of the Dutch letter frequencies
+var DutchProfile = newLetterProfile("Dutch", letterFreqs(map[rune]float64{
+	'a': 7.486, 'b': 1.584, 'c': 1.242, 'd': 5.933, 'e': 18.91, 'f': 0.805, 'g': 3.403,
+	'h': 2.380, 'i': 6.499, 'j': 1.46, 'k': 2.248, 'l': 3.568, 'm': 2.213, 'n': 10.032,
+	'o': 6.063, 'p': 1.57, 'q': 0.009, 'r': 6.411, 's': 3.73, 't': 6.79, 'u': 1.99,
+	'v': 2.85, 'w': 1.52, 'x': 0.036, 'y': 0.035, 'z': 1.39,
+}))
+
+// LanguageScorer scores a character map based on the letter frequencies of a language profile,
+// using the average log likelihood of its characters (see LogLikelihood).
+// Unlike the profile's own Score, a few unexpected characters don't ruin the score of a short text.
+type LanguageScorer struct {
+	// Profile is the profile of the language, only its unigrams are used.
+	Profile *LanguageProfile
+	// WithSpace indicates if the analyzed text is expected to have white spaces or not.
+	WithSpace bool
+}
+
+// Score implements the CharMapScorer interface
+func (s *LanguageScorer) Score(m *CharUseMap) float64 {
+	return LogLikelihood(m, referenceWithSpace(&s.Profile.Unigrams, s.WithSpace), punctuationFreq)
+}
+
+func (s *LanguageScorer) String() string {
+	return s.Profile.Name
+}
+
+// punctuationFreq is the expected frequency of the characters that aren't letters or spaces
+const punctuationFreq = 0.02
+
+// Scorers of the built-in language profiles, expecting spaced text.
+var (
+	EnglishLanguageScorer    = &LanguageScorer{Profile: EnglishProfile, WithSpace: true}
+	FrenchLanguageScorer     = &LanguageScorer{Profile: FrenchProfile, WithSpace: true}
+	GermanLanguageScorer     = &LanguageScorer{Profile: GermanProfile, WithSpace: true}
+	SpanishLanguageScorer    = &LanguageScorer{Profile: SpanishProfile, WithSpace: true}
+	ItalianLanguageScorer    = &LanguageScorer{Profile: ItalianProfile, WithSpace: true}
+	PortugueseLanguageScorer = &LanguageScorer{Profile: PortugueseProfile, WithSpace: true}
+	DutchLanguageScorer      = &LanguageScorer{Profile: DutchProfile, WithSpace: true}
+)
+
+// LanguageScorers lists the scorers of the built-in language profiles.
+var LanguageScorers = []*LanguageScorer{
+	EnglishLanguageScorer,
+	FrenchLanguageScorer,
+	GermanLanguageScorer,
+	SpanishLanguageScorer,
+	ItalianLanguageScorer,
+	PortugueseLanguageScorer,
+	DutchLanguageScorer,
+}
+
+// AutoLanguageScorer scores a character map using the language that best explains it.
+type AutoLanguageScorer struct {
+	// Scorers are the candidate languages, LanguageScorers if empty.
+	Scorers []*LanguageScorer
+}
+
+// Score implements the CharMapScorer interface
+func (s *AutoLanguageScorer) Score(m *CharUseMap) float64 {
+	_, score := s.Detect(m)
+	return score
+}
+
+// Detect returns the scorer of the language best explaining the character map and its score.
+// The first scorer wins ties.
+func (s *AutoLanguageScorer) Detect(m *CharUseMap) (*LanguageScorer, float64) {
+	scorers := s.Scorers
+	if len(scorers) == 0 {
+		scorers = LanguageScorers
+	}
+	var winner *LanguageScorer
+	bestScore := math.Inf(-1)
+	for _, scorer := range scorers {
+		score := scorer.Score(m)
+		if winner == nil || score > bestScore {
+			winner = scorer
+			bestScore = score
+		}
+		if Debug {
+			fmt.Printf("%s - %f\n", scorer, score)
+		}
+	}
+	return winner, bestScore
+}
+
+// DetectLanguage returns the name of the built-in language best explaining the text.
+func DetectLanguage(text []byte) string {
+	scorer, _ := (&AutoLanguageScorer{}).Detect(NewCharMap(text))
+	return scorer.Profile.Name
+}
+
+// letterProfileScale is the number of letters the counts of the built-in profiles are based on
+const letterProfileScale = 100000
+
+// newLetterProfile returns a unigram profile of the letter frequencies.
+func newLetterProfile(name string, freqs *CharUseMap) *LanguageProfile {
+	p := NewLanguageProfile(name, 1)
+	for b, stats := range *freqs {
+		count := math.Round(stats.Freq * letterProfileScale)
+		p.Unigrams[b] = &CharStats{Count: count}
+		p.NGrams[string([]byte{b})] = int(count)
+	}
+	p.Unigrams.updateFreqs()
+	p.ngrams = NewNGramScorer(p.N, p.NGrams)
+	return p
+}
+
+// letterFreqs converts letter frequencies (in percent) to a byte distribution,
+// UTF-8 encoding the non ASCII letters.
+func letterFreqs(letters map[rune]float64) *CharUseMap {
+	var total float64
+	byteFreqs := map[byte]float64{}
+	buf := make([]byte, utf8.UTFMax)
+	for r, freq := range letters {
+		n := utf8.EncodeRune(buf, r)
+		for _, b := range buf[:n] {
+			byteFreqs[b] += freq
+			total += freq
+		}
+	}
+	m := CharUseMap{}
+	for b, freq := range byteFreqs {
+		m[b] = &CharStats{Freq: freq / total}
+	}
+	return &m
+}
@@ -0,0 +1,65 @@
+package kripto
+
+import (
+	"strings"
+	"testing"
+)
+
+var languageSamples = []struct {
+	language string
+	text     string
+}{
+	{"English", "The weather was cold and grey when the old sailor finally came back to the village. " +
+		"Nobody remembered his name, but everybody wanted to hear the stories of the distant islands he had seen."},
+	{"French", "Le temps était froid et gris quand le vieux marin est enfin revenu au village. " +
+		"Personne ne se souvenait de son nom, mais tout le monde voulait entendre les histoires des îles lointaines qu'il avait vues."},
+	{"German", "Das Wetter war kalt und grau, als der alte Seemann endlich in das Dorf zurückkehrte. " +
+		"Niemand erinnerte sich an seinen Namen, aber jeder wollte die Geschichten über die fernen Inseln hören, die er gesehen hatte."},
+	{"Spanish", "El tiempo estaba frío y gris cuando el viejo marinero por fin volvió al pueblo. " +
+		"Nadie recordaba su nombre, pero todos querían escuchar las historias de las islas lejanas que había visto."},
+	{"Italian", "Il tempo era freddo e grigio quando il vecchio marinaio finalmente tornò al villaggio. " +
+		"Nessuno ricordava il suo nome, ma tutti volevano ascoltare le storie delle isole lontane che aveva visto."},
+	{"Portuguese", "O tempo estava frio e cinzento quando o velho marinheiro finalmente voltou à aldeia. " +
+		"Ninguém se lembrava do seu nome, mas todos queriam ouvir as histórias das ilhas distantes que ele tinha visto."},
+	{"Dutch", "Het weer was koud en grijs toen de oude zeeman eindelijk in het dorp terugkwam. " +
+		"Niemand herinnerde zich zijn naam, maar iedereen wilde de verhalen horen over de verre eilanden die hij had gezien."},
+}
+
+func TestDetectLanguage(t *testing.T) {
+	for i, tc := range languageSamples {
+		t.Logf("test case %d\n", i)
+		if o := DetectLanguage([]byte(tc.text)); o != tc.language {
+			t.Fatalf("expected %s\ngot\n%s\n", tc.language, o)
+		}
+	}
+}
+
+func TestDetectLanguageUppercase(t *testing.T) {
+	for i, tc := range languageSamples {
+		t.Logf("test case %d\n", i)
+		if o := DetectLanguage([]byte(strings.ToUpper(tc.text))); o != tc.language {
+			t.Fatalf("expected %s\ngot\n%s\n", tc.language, o)
+		}
+	}
+}
+
+func TestLanguageScorersBreakSingleCharXor(t *testing.T) {
+	for i, tc := range languageSamples {
+		t.Logf("test case %d\n", i)
+		for _, scorer := range []CharMapScorer{&AutoLanguageScorer{}, languageScorer(tc.language)} {
+			o, k := BreakSingleCharXor(SingleCharXor([]byte(tc.text), 0xA7), nil, scorer)
+			if string(o) != tc.text || k != 0xA7 {
+				t.Fatalf("%v: expected to get:\n'%s' using key %x\nbut got\n'%s'\n from key %x\n", scorer, tc.text, 0xA7, o, k)
+			}
+		}
+	}
+}
+
+func languageScorer(language string) *LanguageScorer {
+	for _, scorer := range LanguageScorers {
+		if scorer.Profile.Name == language {
+			return scorer
+		}
+	}
+	return nil
+}
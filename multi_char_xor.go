@@ -1,46 +1,266 @@
 package kripto
 
+import "sort"
+
 // BreakMultiCharXor tries to break encoded text that was encrypted using a repeating multiple character key
 // XORing the data.
+// The key size is found using the Kasiski examination when the data has repeated sequences,
+// otherwise the normalized hamming distance (see GuessMultiCharXorKeySize) is used.
+// The 3 most likely key sizes are tried and the most English looking output is returned,
+// the output being scored using the English quadgrams (see EnglishQuadgrams).
 func BreakMultiCharXor(data []byte, maxKeyLength int) (out []byte, k []byte) {
-	// possible key sizes
-	kSizes := GuessMultiCharXorKeySize(data, maxKeyLength)
-	// test the first possible key (TODO: might want to test more)
-	max := 1
-	if len(kSizes) < max {
-		max = len(kSizes)
-	}
-	possibleKeys := make([][]byte, max)
-
-	for idx, kSize := range kSizes[:max] {
-		// break the ciphertext into blocks of key size length
-		blocks := [][]byte{}
-		for i := 0; i+kSize <= len(data); i = i + kSize {
-			blocks = append(blocks, data[i:i+kSize])
-		}
-
-		// fmt.Printf("%#v\n", blocks)
-
-		// transpose the blocks: make a block that is the first byte of every block,
-		// and a block that is the second byte of every block, and so on.
-		// Each extracted block contains characters encoded with a single character xor key.
-		xordBlocks := make([][]byte, kSize)
-		for _, block := range blocks {
-			for i, b := range block {
-				if len(xordBlocks[i]) == 0 {
-					xordBlocks[i] = []byte{}
+	return BreakMultiCharXorWithScorer(data, nil, EnglishQuadgrams, maxKeyLength)
+}
+
+// BreakMultiCharXorWithScorer is like BreakMultiCharXor but lets the caller process the input
+// and choose how the output is scored, the same way BreakSingleCharXor does.
+// The processFn param is a function that can be used to apply basic input processing (hex/base64 decoding for instance).
+// The scorer param is used to find the key of each column and to pick the best key size.
+func BreakMultiCharXorWithScorer(data []byte, processFn DataProcessFn, scorer CharMapScorer, maxKeyLength int) (out []byte, k []byte) {
+	stats := BreakMultiCharXorCandidates(data, processFn, scorer, maxKeyLength, 3)
+	if len(stats) == 0 {
+		return
+	}
+	return stats[0].Text, stats[0].Key
+}
+
+// BreakMultiCharXorCandidates decrypts the data using the n most likely key sizes (or all of them
+// if n isn't positive) and returns the candidates ranked using the passed scorer.
+// See BreakMultiCharXorWithScorer for the processFn and scorer params.
+// When the scorer implements TextScorer, the key found column by column is refined by scoring
+// the whole text. A key made of a repeated shorter key is shortened, so the KeySize of a candidate
+// is the length of its key and not the tried key size, and the keys already found for another size
+// are dropped: fewer than n candidates can be returned.
+func BreakMultiCharXorCandidates(data []byte, processFn DataProcessFn, scorer CharMapScorer, maxKeyLength, n int) MultiByteKeyColStats {
+	if processFn != nil {
+		data = processFn(data)
+	}
+	kSizes := multiCharXorKeySizes(data, maxKeyLength)
+	if n > 0 && n < len(kSizes) {
+		kSizes = kSizes[:n]
+	}
+
+	stats := MultiByteKeyColStats{}
+	seen := map[string]bool{}
+	for _, kSize := range kSizes {
+		xordBlocks := transposeBlocks(data, kSize)
+
+		key := make([]byte, kSize)
+		for i, cypherBlock := range xordBlocks {
+			key[i] = MostLikelyXorKeyWithScorer(cypherBlock, scorer)
+		}
+		if ts, ok := scorer.(TextScorer); ok {
+			key = searchXorKey(data, key, scorer, ts)
+			key = refineXorKey(data, key, ts)
+		}
+		key = shortestRepeatingKey(key)
+		// multiples of the key size often end up finding the same key
+		if seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+
+		text := MultiCharXor(data, key)
+		m := NewCharMap(text)
+		stats = append(stats, &MultiByteKeyStats{
+			CharMap: m,
+			Score:   scoreText(scorer, text, m),
+			Text:    text,
+			Key:     key,
+			KeySize: len(key),
+		})
+	}
+
+	// stable so the most likely key size wins ties
+	sort.Stable(stats)
+	return stats
+}
+
+// maxXorKeySearch is the number of bytes searchXorKey decrypts at most.
+const maxXorKeySearch = 1 << 22
+
+// searchXorKey tries every combination of the most likely key bytes of each column
+// and returns the key decrypting the data to the best scoring text (the passed key wins ties).
+// The key bytes of a column are the ones decrypting it to printable characters, ranked using the
+// scorer's Score. Fewer key bytes are tried per column as the data and the key get longer,
+// the key is returned as is when only one key byte per column could be tried.
+// It finds the keys of short texts, whose columns are too short to be broken one by one.
+func searchXorKey(data, key []byte, scorer CharMapScorer, ts TextScorer) []byte {
+	if len(key) == 0 || len(data) == 0 {
+		return key
+	}
+	budget := maxXorKeySearch / len(data)
+	perCol := 1
+	for perCol < 256 && combinations(perCol+1, len(key)) <= budget {
+		perCol++
+	}
+	if perCol < 2 {
+		return key
+	}
+
+	candidates := make([][]byte, len(key))
+	for i, col := range transposeBlocks(data, len(key)) {
+		stats := ByteKeyColStats{}
+		for _, k := range printableXorKeys(col) {
+			m := NewCharMap(SingleCharXor(col, k))
+			stats = append(stats, &ByteKeyStats{CharMap: m, Score: scorer.Score(m), Key: k})
+		}
+		if len(stats) == 0 {
+			return key
+		}
+		// stable so the lowest key byte wins ties
+		sort.Stable(stats)
+		if len(stats) > perCol {
+			stats = stats[:perCol]
+		}
+		for _, s := range stats {
+			candidates[i] = append(candidates[i], s.Key)
+		}
+	}
+
+	best := append([]byte{}, key...)
+	bestScore := ts.ScoreText(MultiCharXor(data, key))
+	current := make([]byte, len(key))
+	// the index of the key byte tried for each column, odometer style
+	idx := make([]int, len(key))
+	for {
+		for i, j := range idx {
+			current[i] = candidates[i][j]
+		}
+		if score := ts.ScoreText(MultiCharXor(data, current)); score > bestScore {
+			bestScore = score
+			copy(best, current)
+		}
+		i := len(idx) - 1
+		for ; i >= 0; i-- {
+			idx[i]++
+			if idx[i] < len(candidates[i]) {
+				break
+			}
+			idx[i] = 0
+		}
+		if i < 0 {
+			return best
+		}
+	}
+}
+
+// combinations returns n to the power of k, capped to avoid overflows.
+func combinations(n, k int) int {
+	c := 1
+	for i := 0; i < k; i++ {
+		c *= n
+		if c > maxXorKeySearch {
+			return maxXorKeySearch + 1
+		}
+	}
+	return c
+}
+
+// refineXorKey improves a repeating xor key one byte at a time by scoring the whole decrypted text,
+// which lets scorers looking at sequences of characters (such as n-gram scorers) correct the key bytes
+// guessed column by column. The lowest key byte wins ties.
+// Only the key bytes decrypting their whole column to printable characters are tried,
+// columns without such key bytes are left as is.
+func refineXorKey(data, key []byte, scorer TextScorer) []byte {
+	key = append([]byte{}, key...)
+	candidates := make([][]byte, len(key))
+	for i, col := range transposeBlocks(data, len(key)) {
+		candidates[i] = printableXorKeys(col)
+	}
+
+	best := scorer.ScoreText(MultiCharXor(data, key))
+	for improved := true; improved; {
+		improved = false
+		for i := range key {
+			current := key[i]
+			for _, k := range candidates[i] {
+				key[i] = k
+				score := scorer.ScoreText(MultiCharXor(data, key))
+				if score > best || (score == best && k < current) {
+					best = score
+					current = k
+					improved = true
 				}
-				xordBlocks[i] = append(xordBlocks[i], b)
 			}
+			key[i] = current
 		}
+	}
+	return key
+}
 
-		key := make([]byte, kSize)
-		for i, cypherBlock := range xordBlocks {
-			// fmt.Printf("%d %#v\n", i, cypherBlock)
-			key[i] = MostLikelyXorKey(cypherBlock)
+// printableXorKeys returns the single byte keys decrypting the block to printable characters.
+func printableXorKeys(block []byte) []byte {
+	keys := []byte{}
+	for k := 0; k < 256; k++ {
+		printable := true
+		for _, b := range block {
+			if !IsPrintable(b ^ byte(k)) {
+				printable = false
+				break
+			}
+		}
+		if printable {
+			keys = append(keys, byte(k))
 		}
-		possibleKeys[idx] = key
 	}
+	return keys
+}
+
+// shortestRepeatingKey returns the shortest key that repeated gives the passed key,
+// for instance "ABCABC" gives "ABC".
+func shortestRepeatingKey(key []byte) []byte {
+	for size := 1; size < len(key); size++ {
+		if len(key)%size != 0 {
+			continue
+		}
+		repeating := true
+		for i := size; i < len(key); i++ {
+			if key[i] != key[i-size] {
+				repeating = false
+				break
+			}
+		}
+		if repeating {
+			return key[:size]
+		}
+	}
+	return key
+}
+
+// minXorColumnLen is the number of bytes each column needs for a key size to be tried,
+// shorter columns can be decrypted to about anything.
+const minXorColumnLen = 4
 
-	return MultiCharXor(data, possibleKeys[0]), possibleKeys[0]
+// multiCharXorKeySizes returns the possible key sizes, most likely first.
+// Key sizes giving columns shorter than minXorColumnLen aren't returned.
+func multiCharXorKeySizes(data []byte, maxKeyLength int) []int {
+	if limit := len(data) / minXorColumnLen; maxKeyLength > limit {
+		maxKeyLength = limit
+	}
+	kSizes := KasiskiKeySizes(data, 3, maxKeyLength).Sizes()
+	if len(kSizes) == 0 {
+		kSizes = GuessMultiCharXorKeySize(data, maxKeyLength)
+	}
+	return kSizes
+}
+
+// transposeBlocks breaks the data into blocks of kSize length (ignoring the trailing partial block)
+// and transposes them: the first returned block is the first byte of every block,
+// the second is the second byte of every block, and so on.
+// When the data was encoded with a repeating key of kSize length,
+// each transposed block contains characters encoded with a single character xor key.
+func transposeBlocks(data []byte, kSize int) [][]byte {
+	blocks := [][]byte{}
+	for i := 0; i+kSize <= len(data); i = i + kSize {
+		blocks = append(blocks, data[i:i+kSize])
+	}
+
+	xordBlocks := make([][]byte, kSize)
+	for _, block := range blocks {
+		for i, b := range block {
+			xordBlocks[i] = append(xordBlocks[i], b)
+		}
+	}
+	return xordBlocks
 }
@@ -1,9 +1,12 @@
 package kripto
 
-import "testing"
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
 
 func TestBreakMultiCharXor(t *testing.T) {
-	t.Skip("To hard to test with only a few letters, we need to apply another approach like Kasiski's for instance")
 	testCases := []struct {
 		input  []byte
 		key    string
@@ -34,3 +37,100 @@ func TestBreakMultiCharXor(t *testing.T) {
 		}
 	}
 }
+
+func TestBreakMultiCharXorFixture(t *testing.T) {
+	data, err := ioutil.ReadFile(fixturePath("6.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, k := BreakMultiCharXor(DeBase64(data), 40)
+	if string(k) != "Terminator X: Bring the noise" {
+		t.Fatalf("key not properly found, got '%s'", string(k))
+	}
+	if !strings.HasPrefix(string(o), "I'm back and I'm ringin' the bell") {
+		t.Fatalf("unexpected output\n%s\n", string(o))
+	}
+}
+
+func TestBreakMultiCharXorCandidates(t *testing.T) {
+	data, err := ioutil.ReadFile(fixturePath("6.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := BreakMultiCharXorCandidates(data, DeBase64, &EnglishScorer{WithSpace: true}, 40, 5)
+	if len(stats) != 5 {
+		t.Fatalf("expected 5 candidates, got %d", len(stats))
+	}
+	if stats[0].KeySize != 29 || string(stats[0].Key) != "Terminator X: Bring the noise" {
+		t.Fatalf("unexpected winner %d: %q", stats[0].KeySize, stats[0].Key)
+	}
+	for i, s := range stats {
+		if len(s.Key) != s.KeySize {
+			t.Fatalf("candidate %d has a key of %d bytes, expected %d", i, len(s.Key), s.KeySize)
+		}
+		if i > 0 && s.Score > stats[i-1].Score {
+			t.Fatalf("candidates aren't ranked\n%s", stats)
+		}
+	}
+}
+
+func TestBreakMultiCharXorCandidatesAllKeySizes(t *testing.T) {
+	data := MultiCharXor([]byte("Burning 'em, if you ain't quick and nimble"), []byte("ICE"))
+	all := len(multiCharXorKeySizes(data, 6))
+	for i, n := range []int{0, -1} {
+		t.Logf("test case %d\n", i)
+		if stats := BreakMultiCharXorCandidates(data, nil, &EnglishScorer{WithSpace: true}, 6, n); len(stats) == 0 || len(stats) > all {
+			t.Fatalf("expected up to %d candidates, got %d", all, len(stats))
+		}
+	}
+}
+
+// letterScorer scores text without spaces or punctuation using the English letter frequencies.
+type letterScorer struct{}
+
+func (s *letterScorer) Score(m *CharUseMap) float64 {
+	var score float64
+	freqs := *EnglishLetterFreqs
+	// iterate in byte order so equivalent maps get the exact same score
+	for i := 0; i < 256; i++ {
+		b := byte(i)
+		stats, ok := (*m)[b]
+		if !ok {
+			continue
+		}
+		if en, ok := freqs[b]; ok {
+			score += stats.Count * en.Freq
+			continue
+		}
+		score -= stats.Count
+	}
+	return score
+}
+
+func TestBreakMultiCharXorWithScorer(t *testing.T) {
+	testCases := []struct {
+		input  string
+		key    string
+		fn     func([]byte) []byte
+		scorer CharMapScorer
+	}{
+		{"itwasthebestoftimesitwastheworstoftimesitwastheageofwisdomitwastheageoffoolishnessitwastheepochofbelief" +
+			"itwastheepochofincredulityitwasttheseasonoflightitwastheseasonofdarknessitwasthespringofhope" +
+			"itwasthewinterofdespair",
+			"DICKENS",
+			nil,
+			&letterScorer{},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, k := BreakMultiCharXorWithScorer(MultiCharXor([]byte(tc.input), []byte(tc.key)), tc.fn, tc.scorer, 20)
+		if string(k) != tc.key {
+			t.Fatalf("key not properly found, expected '%s', got '%s'", tc.key, string(k))
+		}
+		if string(o) != tc.input {
+			t.Fatalf("expected %s\ngot\n%s\n", tc.input, string(o))
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package kripto
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run gen_ngrams.go
+
+// EnglishTrigrams scores text based on the English trigrams (3 letter sequences).
+var EnglishTrigrams = NewNGramScorer(3, parseNGramCounts(englishTrigrams, 3))
+
+// EnglishQuadgrams scores text based on the English quadgrams (4 letter sequences).
+// It is the most accurate English scorer of the package, but needs the text to be contiguous.
+var EnglishQuadgrams = NewNGramScorer(4, parseNGramCounts(englishQuadgrams, 4))
+
+// NGramScorer scores text using the log probabilities of its n-grams (sequences of n characters).
+// ASCII letters are case insensitive, all white spaces are treated as a space
+// and ASCII control characters are never expected. Non ASCII bytes are kept as is
+// so UTF-8 encoded text can be scored.
+// The score is the average log10 probability of the text n-grams, so it is always negative
+// and the closer to 0, the more likely the text is.
+//
+// NGramScorer implements CharMapScorer (using the unigram probabilities since a char map doesn't keep
+// the order of the characters) and TextScorer.
+type NGramScorer struct {
+	// N is the length of the n-grams.
+	N int
+
+	logProbs map[uint32]float64
+	unigrams [256]float64
+	// floor is the log probability of unseen n-grams.
+	floor float64
+}
+
+// NewNGramScorer returns a scorer for the n-grams counted in a reference text.
+// The counted n-grams need to be normalized the way the scorer does it (see ngramSymbols).
+// n can't be greater than 4.
+func NewNGramScorer(n int, counts map[string]int) *NGramScorer {
+	if n < 1 || n > 4 {
+		panic("kripto: n-gram length must be between 1 and 4")
+	}
+	s := &NGramScorer{N: n, logProbs: make(map[uint32]float64, len(counts))}
+
+	var total float64
+	var unigramCounts [256]float64
+	for gram, count := range counts {
+		if len(gram) != n {
+			continue
+		}
+		total += float64(count)
+		unigramCounts[gram[0]] += float64(count)
+	}
+	if total == 0 {
+		total = 1
+	}
+	s.floor = math.Log10(0.01 / total)
+
+	for gram, count := range counts {
+		if len(gram) != n {
+			continue
+		}
+		s.logProbs[packNGram([]byte(gram))] = math.Log10(float64(count) / total)
+	}
+	for i, count := range unigramCounts {
+		s.unigrams[i] = s.floor
+		if i != 0 && count > 0 {
+			s.unigrams[i] = math.Log10(count / total)
+		}
+	}
+	return s
+}
+
+// ScoreText implements the TextScorer interface
+func (s *NGramScorer) ScoreText(text []byte) float64 {
+	seq := ngramSymbols(text)
+	if len(seq) == 0 {
+		return s.floor
+	}
+	// too short to have a single n-gram
+	if len(seq) < s.N {
+		var sum float64
+		for _, b := range seq {
+			sum += s.unigrams[b]
+		}
+		return sum / float64(len(seq))
+	}
+
+	var sum float64
+	for i := 0; i+s.N <= len(seq); i++ {
+		logProb, ok := s.logProbs[packNGram(seq[i:i+s.N])]
+		if !ok {
+			logProb = s.floor
+		}
+		sum += logProb
+	}
+	return sum / float64(len(seq)-s.N+1)
+}
+
+// Score implements the CharMapScorer interface
+func (s *NGramScorer) Score(m *CharUseMap) float64 {
+	var sum, count float64
+	// iterate in byte order so equivalent maps get the exact same score
+	for i := 0; i < 256; i++ {
+		stats, ok := (*m)[byte(i)]
+		if !ok {
+			continue
+		}
+		sum += stats.Count * s.unigrams[ngramSymbol(byte(i))]
+		count += stats.Count
+	}
+	if count == 0 {
+		return s.floor
+	}
+	return sum / count
+}
+
+// ngramSymbol maps a byte to the symbol used by the n-gram scorers:
+// letters are lowercased, white spaces become a space, other printable characters
+// and non ASCII bytes are kept and ASCII control characters become 0.
+func ngramSymbol(b byte) byte {
+	switch {
+	case b >= 'A' && b <= 'Z':
+		return b + 'a' - 'A'
+	case b == 9 || b == 10 || b == 12 || b == 13:
+		return ' '
+	case IsPrintable(b) || b >= 0x80:
+		return b
+	default:
+		return 0
+	}
+}
+
+// ngramSymbols normalizes the text for the n-gram scorers, see ngramSymbol.
+func ngramSymbols(text []byte) []byte {
+	seq := make([]byte, len(text))
+	for i, b := range text {
+		seq[i] = ngramSymbol(b)
+	}
+	return seq
+}
+
+// packNGram packs up to 4 bytes in an integer so it can be used as a cheap map key.
+func packNGram(gram []byte) uint32 {
+	var v uint32
+	for _, b := range gram {
+		v = v<<8 | uint32(b)
+	}
+	return v
+}
+
+// parseNGramCounts parses a table of "<n-gram> <count>" lines.
+func parseNGramCounts(table string, n int) map[string]int {
+	counts := map[string]int{}
+	for _, line := range strings.Split(table, "\n") {
+		if len(line) < n+2 {
+			continue
+		}
+		count, err := strconv.Atoi(line[n+1:])
+		if err != nil {
+			continue
+		}
+		counts[line[:n]] = count
+	}
+	return counts
+}
@@ -0,0 +1,82 @@
+package kripto
+
+import "testing"
+
+func TestNGramScorerScoreText(t *testing.T) {
+	testCases := []struct {
+		input     []string
+		winnerIDX int
+	}{
+		{[]string{
+			`q]]Y[\UqA^[YWSB]G\V]TPSQ]\`,
+			`Cooking MC's like a pound of bacon`,
+			`Q}}y{|u2_Q5a2~{yw2s2b}g|v2}t2psq}|`,
+			"Dhhlni`'JD t'knlb'f'whric'ha'efdhi",
+		},
+			1},
+		{[]string{
+			"ETAOIN SHRDLU ETAOIN SHRDLU",
+			"the quick brown fox jumps over",
+			"eeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+			"\x00\x01\x02\x03 the \x04\x05\x06\x07",
+		},
+			1},
+	}
+
+	for i, tc := range testCases {
+		for _, scorer := range []*NGramScorer{EnglishTrigrams, EnglishQuadgrams} {
+			t.Logf("test case %d (%d-grams)\n", i, scorer.N)
+			winnerIDX := -1
+			var bestScore float64
+			for j, s := range tc.input {
+				score := scorer.ScoreText([]byte(s))
+				if score >= 0 {
+					t.Fatalf("log probabilities should be negative, got %f", score)
+				}
+				if winnerIDX == -1 || score > bestScore {
+					winnerIDX = j
+					bestScore = score
+				}
+			}
+			if winnerIDX != tc.winnerIDX {
+				t.Fatalf("expected %q\ngot\n%q\n", tc.input[tc.winnerIDX], tc.input[winnerIDX])
+			}
+		}
+	}
+}
+
+func TestNewNGramScorer(t *testing.T) {
+	scorer := NewNGramScorer(2, map[string]int{"ab": 3, "ba": 1, "abc": 10})
+	if scorer.ScoreText([]byte("ab")) <= scorer.ScoreText([]byte("ba")) {
+		t.Fatal("expected the most common bigram to score higher")
+	}
+	if scorer.ScoreText([]byte("AB")) != scorer.ScoreText([]byte("ab")) {
+		t.Fatal("expected the scorer to be case insensitive")
+	}
+	if scorer.ScoreText([]byte("zz")) >= scorer.ScoreText([]byte("ba")) {
+		t.Fatal("expected unseen bigrams to score lower than seen ones")
+	}
+}
+
+func TestBreakXorWithNGramScorer(t *testing.T) {
+	plain := "It was the best of times, it was the worst of times, it was the age of wisdom, " +
+		"it was the age of foolishness, it was the epoch of belief, it was the epoch of incredulity, " +
+		"it was the season of Light, it was the season of Darkness."
+
+	testCases := []string{"ICE", "KRIPTO", "Dickens!"}
+	for i, key := range testCases {
+		t.Logf("test case %d\n", i)
+		o, k := BreakMultiCharXorWithScorer(MultiCharXor([]byte(plain), []byte(key)), nil, EnglishQuadgrams, 20)
+		if string(k) != key {
+			t.Fatalf("key not properly found, expected '%s', got '%s'", key, string(k))
+		}
+		if string(o) != plain {
+			t.Fatalf("expected %s\ngot\n%s\n", plain, string(o))
+		}
+	}
+
+	o, k := BreakSingleCharXor([]byte("1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736"), DeHex, EnglishQuadgrams)
+	if string(o) != "Cooking MC's like a pound of bacon" || k != 'X' {
+		t.Fatalf("expected 'Cooking MC's like a pound of bacon' using key X\ngot\n'%s' using key %s", o, string(k))
+	}
+}
@@ -0,0 +1,103 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+)
+
+// Oracle encrypts attacker controlled input, usually along with data the attacker doesn't know.
+type Oracle func(input []byte) []byte
+
+// BlockMode identifies a block cipher mode of operation.
+type BlockMode int
+
+// Block cipher modes of operation
+const (
+	ModeECB BlockMode = iota + 1
+	ModeCBC
+)
+
+func (m BlockMode) String() string {
+	switch m {
+	case ModeECB:
+		return "ECB"
+	case ModeCBC:
+		return "CBC"
+	}
+	return "unknown"
+}
+
+// EncryptionOracle encrypts data using AES-128 with a random key, randomly picking ECB or CBC mode
+// (with a random IV) and surrounding the data with 5 to 10 random bytes before and after it.
+// The data is padded using PKCS#7.
+type EncryptionOracle struct {
+	rand io.Reader
+	// mode is the mode used by the last call to Encrypt
+	mode BlockMode
+}
+
+// NewEncryptionOracle returns an oracle using rnd as its source of randomness (crypto/rand if nil).
+func NewEncryptionOracle(rnd io.Reader) *EncryptionOracle {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	return &EncryptionOracle{rand: rnd}
+}
+
+// Encrypt implements the Oracle function type.
+func (o *EncryptionOracle) Encrypt(input []byte) []byte {
+	key := o.randomBytes(aes.BlockSize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+
+	data := append(o.randomBytes(5+o.randomInt(6)), input...)
+	data = append(data, o.randomBytes(5+o.randomInt(6))...)
+	data = pkcs7Pad(data, aes.BlockSize)
+
+	var mode cipher.BlockMode
+	if o.randomInt(2) == 0 {
+		o.mode = ModeECB
+		mode = NewECBEncrypter(block)
+	} else {
+		o.mode = ModeCBC
+		mode = cipher.NewCBCEncrypter(block, o.randomBytes(aes.BlockSize))
+	}
+	out := make([]byte, len(data))
+	mode.CryptBlocks(out, data)
+	return out
+}
+
+// LastMode returns the mode used by the last call to Encrypt, to check the detection.
+func (o *EncryptionOracle) LastMode() BlockMode {
+	return o.mode
+}
+
+func (o *EncryptionOracle) randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(o.rand, b); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// randomInt returns a random number in [0, n)
+func (o *EncryptionOracle) randomInt(n int) int {
+	return int(binary.BigEndian.Uint32(o.randomBytes(4)) % uint32(n))
+}
+
+// DetectBlockMode queries the oracle once to determine whether it encrypts in ECB or CBC mode.
+// The input is made of 3 identical blocks so that, whatever the length of the data the oracle adds
+// before it (as long as it is not longer than a block), at least 2 identical aligned plaintext blocks are encrypted.
+// ECB encrypts them to identical ciphertext blocks (see IsECB), any other mode is reported as CBC.
+func DetectBlockMode(oracle Oracle, blockSize int) BlockMode {
+	if IsECB(oracle(bytes.Repeat([]byte{'A'}, 3*blockSize)), blockSize) {
+		return ModeECB
+	}
+	return ModeCBC
+}
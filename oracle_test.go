@@ -0,0 +1,33 @@
+package kripto
+
+import (
+	"crypto/aes"
+	"math/rand"
+	"testing"
+)
+
+func TestDetectBlockMode(t *testing.T) {
+	oracle := NewEncryptionOracle(rand.New(rand.NewSource(11)))
+	seen := map[BlockMode]int{}
+	for i := 0; i < 100; i++ {
+		mode := DetectBlockMode(oracle.Encrypt, aes.BlockSize)
+		if mode != oracle.LastMode() {
+			t.Fatalf("query %d: expected %s but detected %s\n", i, oracle.LastMode(), mode)
+		}
+		seen[mode]++
+	}
+	if seen[ModeECB] == 0 || seen[ModeCBC] == 0 {
+		t.Fatalf("expected the oracle to use both modes but got %v\n", seen)
+	}
+}
+
+func TestEncryptionOracle(t *testing.T) {
+	oracle := NewEncryptionOracle(nil)
+	for _, n := range []int{0, 1, 16, 33} {
+		// 10 to 20 random bytes are added before padding
+		out := oracle.Encrypt(make([]byte, n))
+		if len(out)%aes.BlockSize != 0 || len(out) < n+11 || len(out) > n+20+aes.BlockSize {
+			t.Fatalf("unexpected ciphertext length %d for %d bytes\n", len(out), n)
+		}
+	}
+}
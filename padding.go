@@ -0,0 +1,71 @@
+package kripto
+
+import (
+	"bytes"
+	"errors"
+)
+
+// PaddingError is returned when removing an invalid padding.
+type PaddingError struct {
+	Reason string
+}
+
+func (e *PaddingError) Error() string {
+	return "kripto: invalid padding: " + e.Reason
+}
+
+var (
+	// ErrInvalidBlockSize is returned when padding to a block size that PKCS#7 doesn't support.
+	ErrInvalidBlockSize = errors.New("kripto: block size must be between 1 and 255")
+
+	// ErrPaddingLength is returned when the padded data is empty or isn't made of full blocks.
+	ErrPaddingLength = &PaddingError{Reason: "data length isn't a multiple of the block size"}
+	// ErrPaddingValue is returned when the last byte isn't a valid padding length.
+	ErrPaddingValue = &PaddingError{Reason: "padding length must be between 1 and the block size"}
+	// ErrPaddingBytes is returned when the padding bytes don't all match the padding length.
+	ErrPaddingBytes = &PaddingError{Reason: "padding bytes don't match the padding length"}
+)
+
+// PKCS7Pad pads the data to a multiple of blockSize using PKCS#7 (RFC 5652 section 6.3):
+// n bytes of value n are added, a full block is added when the data is already a multiple of blockSize.
+// The data isn't modified.
+func PKCS7Pad(data []byte, blockSize int) ([]byte, error) {
+	if blockSize < 1 || blockSize > 255 {
+		return nil, ErrInvalidBlockSize
+	}
+	return pkcs7Pad(append([]byte{}, data...), blockSize), nil
+}
+
+// PKCS7Unpad removes the PKCS#7 padding of the data, returning ErrInvalidBlockSize or one of the
+// *PaddingError errors (ErrPaddingLength, ErrPaddingValue and ErrPaddingBytes) when invalid.
+// The returned slice shares the data underlying array.
+func PKCS7Unpad(data []byte, blockSize int) ([]byte, error) {
+	if blockSize < 1 || blockSize > 255 {
+		return nil, ErrInvalidBlockSize
+	}
+	if len(data) == 0 || len(data)%blockSize != 0 {
+		return nil, ErrPaddingLength
+	}
+	n := int(data[len(data)-1])
+	if n == 0 || n > blockSize {
+		return nil, ErrPaddingValue
+	}
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, ErrPaddingBytes
+		}
+	}
+	return data[:len(data)-n], nil
+}
+
+// IsPaddingError indicates if err is one of the errors returned by PKCS7Unpad for an invalid padding.
+func IsPaddingError(err error) bool {
+	_, ok := err.(*PaddingError)
+	return ok
+}
+
+// pkcs7Pad appends the PKCS#7 padding to the data.
+func pkcs7Pad(data []byte, blockSize int) []byte {
+	n := blockSize - len(data)%blockSize
+	return append(data, bytes.Repeat([]byte{byte(n)}, n)...)
+}
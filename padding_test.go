@@ -0,0 +1,92 @@
+package kripto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"testing"
+)
+
+func TestPKCS7Pad(t *testing.T) {
+	testCases := []struct {
+		input     string
+		blockSize int
+		output    string
+		err       error
+	}{
+		{"YELLOW SUBMARINE", 20, "YELLOW SUBMARINE\x04\x04\x04\x04", nil},
+		{"YELLOW SUBMARINE", 16, "YELLOW SUBMARINE" + string(bytes.Repeat([]byte{16}, 16)), nil},
+		{"", 8, string(bytes.Repeat([]byte{8}, 8)), nil},
+		{"YELLOW", 1, "YELLOW\x01", nil},
+		{"YELLOW", 255, "YELLOW" + string(bytes.Repeat([]byte{249}, 249)), nil},
+		{"YELLOW", 0, "", ErrInvalidBlockSize},
+		{"YELLOW", 256, "", ErrInvalidBlockSize},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, err := PKCS7Pad([]byte(tc.input), tc.blockSize)
+		if err != tc.err || string(o) != tc.output {
+			t.Fatalf("expected %q (%v)\ngot\n%q (%v)\n", tc.output, tc.err, o, err)
+		}
+		if err != nil {
+			continue
+		}
+		if u, err := PKCS7Unpad(o, tc.blockSize); err != nil || string(u) != tc.input {
+			t.Fatalf("expected to unpad %q but got %q (%v)\n", tc.input, u, err)
+		}
+	}
+}
+
+func TestPKCS7Unpad(t *testing.T) {
+	testCases := []struct {
+		input     string
+		blockSize int
+		output    string
+		err       error
+	}{
+		{"ICE ICE BABY\x04\x04\x04\x04", 16, "ICE ICE BABY", nil},
+		{"ICE ICE BABY\x05\x05\x05\x05", 16, "", ErrPaddingBytes},
+		{"ICE ICE BABY\x01\x02\x03\x04", 16, "", ErrPaddingBytes},
+		{"ICE ICE BABY\x04\x04\x04\x00", 16, "", ErrPaddingValue},
+		{"ICE ICE BABY\x04\x04\x04\x11", 16, "", ErrPaddingValue},
+		{"ICE ICE BABY\x04\x04\x04", 16, "", ErrPaddingLength},
+		{"", 16, "", ErrPaddingLength},
+		{"ICE ICE BABY\x04\x04\x04\x04", 0, "", ErrInvalidBlockSize},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, err := PKCS7Unpad([]byte(tc.input), tc.blockSize)
+		if err != tc.err || string(o) != tc.output {
+			t.Fatalf("expected %q (%v)\ngot\n%q (%v)\n", tc.output, tc.err, o, err)
+		}
+		if err != nil && err != ErrInvalidBlockSize && !IsPaddingError(err) {
+			t.Fatalf("expected a padding error but got %v\n", err)
+		}
+	}
+}
+
+func TestECBEncryptDecrypt(t *testing.T) {
+	c, err := aes.NewCipher(commonKey128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{0, 1, 15, 16, 17, 64} {
+		plaintext := commonInput[:n]
+		ciphertext := ECBEncrypt(c, plaintext)
+		if len(ciphertext) != (len(plaintext)/aes.BlockSize+1)*aes.BlockSize {
+			t.Fatalf("unexpected ciphertext length %d for %d bytes\n", len(ciphertext), len(plaintext))
+		}
+		if o, err := ECBDecrypt(c, ciphertext); err != nil || !bytes.Equal(o, plaintext) {
+			t.Fatalf("expected\n%x\ngot\n%x (%v)\n", plaintext, o, err)
+		}
+	}
+
+	if _, err := ECBDecrypt(c, commonInput[:20]); err != ErrPaddingLength {
+		t.Fatalf("expected %v but got %v\n", ErrPaddingLength, err)
+	}
+	// the NIST vectors aren't padded
+	if _, err := ECBDecrypt(c, ecbAESTests[0].out); !IsPaddingError(err) {
+		t.Fatalf("expected a padding error but got %v\n", err)
+	}
+}
@@ -1,24 +1,324 @@
 package kripto
 
 import (
+	"bytes"
+	"encoding/ascii85"
+	"encoding/base32"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
 )
 
-// DeHex decodes a hex encoded string (but swallows errors)
+// DataProcessErrFn is a DataProcessFn that can fail (when decoding malformed data for instance)
+type DataProcessErrFn func(data []byte) ([]byte, error)
+
+// DecodeError is returned when decoding malformed data.
+type DecodeError struct {
+	// Encoding is the name of the encoding of the data (hex, base64...)
+	Encoding string
+	// Offset is the offset in the input data where the decoding failed
+	Offset int
+	// Reason explains why the decoding failed
+	Reason string
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("kripto: invalid %s data at offset %d: %s", e.Encoding, e.Offset, e.Reason)
+}
+
+// DeHex decodes a hex encoded string (but swallows errors, see DecodeHex)
 func DeHex(input []byte) []byte {
 	decStr := make([]byte, hex.DecodedLen(len(input)))
 	hex.Decode(decStr, input)
 	return decStr
 }
 
-// DeBase64 decodes a base64 string
+// DeBase64 decodes a base64 string (and panics on errors, see DecodeBase64)
 func DeBase64(input []byte) []byte {
-	enc := base64.StdEncoding
+	return mustDecode(DecodeBase64, input)
+}
+
+// DecodeHex decodes a hex encoded string, a *DecodeError is returned if the input is malformed.
+// White spaces are ignored (like HexEncoding and NewDeHexReader do), the offsets of the errors
+// are still relative to the input.
+func DecodeHex(input []byte) ([]byte, error) {
+	out := make([]byte, 0, len(input)/2)
+	last := -1
+	for i, b := range input {
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		v := fromHexChar(b)
+		if v < 0 {
+			return nil, &DecodeError{Encoding: "hex", Offset: i, Reason: fmt.Sprintf("invalid byte %#U", rune(b))}
+		}
+		if last < 0 {
+			last = i
+			continue
+		}
+		out = append(out, byte(fromHexChar(input[last])<<4|v))
+		last = -1
+	}
+	if last >= 0 {
+		return nil, &DecodeError{Encoding: "hex", Offset: last, Reason: "odd length"}
+	}
+	return out, nil
+}
+
+// DecodeBase64 decodes a standard (padded) base64 string,
+// a *DecodeError is returned if the input is malformed.
+func DecodeBase64(input []byte) ([]byte, error) {
+	return decodeWith(base64.StdEncoding, "base64", base64Alphabet, input)
+}
+
+// DeBase32 decodes a standard base32 string (malformed input decodes to nil, see DecodeBase32)
+func DeBase32(input []byte) []byte {
+	return decodeOrNil(DecodeBase32, input)
+}
+
+// DecodeBase32 decodes a standard base32 string (RFC 4648), padded or not.
+func DecodeBase32(input []byte) ([]byte, error) {
+	return decodeWith(base32.StdEncoding.WithPadding(base32.NoPadding), "base32", base32Alphabet, trimPadding(input))
+}
+
+// DeBase32Hex decodes a base32 string using the extended hex alphabet
+// (malformed input decodes to nil, see DecodeBase32Hex)
+func DeBase32Hex(input []byte) []byte {
+	return decodeOrNil(DecodeBase32Hex, input)
+}
+
+// DecodeBase32Hex decodes a base32 string using the extended hex alphabet (RFC 4648), padded or not.
+func DecodeBase32Hex(input []byte) ([]byte, error) {
+	return decodeWith(base32.HexEncoding.WithPadding(base32.NoPadding), "base32hex", base32HexAlphabet, trimPadding(input))
+}
+
+// DeBase58 decodes a base58 string using the Bitcoin alphabet (malformed input decodes to nil, see DecodeBase58)
+func DeBase58(input []byte) []byte {
+	return decodeOrNil(DecodeBase58, input)
+}
+
+// DecodeBase58 decodes a base58 string using the Bitcoin alphabet,
+// each leading '1' is decoded as a zero byte.
+func DecodeBase58(input []byte) ([]byte, error) {
+	zeros := 0
+	for zeros < len(input) && input[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	digit := new(big.Int)
+	for i := zeros; i < len(input); i++ {
+		v := bytes.IndexByte(base58Alphabet, input[i])
+		if v < 0 {
+			return nil, &DecodeError{Encoding: "base58", Offset: i, Reason: fmt.Sprintf("invalid byte %#U", rune(input[i]))}
+		}
+		n.Mul(n, radix)
+		n.Add(n, digit.SetInt64(int64(v)))
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// DeASCII85 decodes an ascii85 string (malformed input decodes to nil, see DecodeASCII85)
+func DeASCII85(input []byte) []byte {
+	return decodeOrNil(DecodeASCII85, input)
+}
+
+// DecodeASCII85 decodes an ascii85 string, delimited by <~ and ~> or not.
+func DecodeASCII85(input []byte) ([]byte, error) {
+	if body, ok := trimASCII85(input); ok {
+		input = body
+	}
+	dst := make([]byte, 4*len(input)+4)
+	n, _, err := ascii85.Decode(dst, input, true)
+	if err != nil {
+		if offset, ok := err.(ascii85.CorruptInputError); ok {
+			return nil, &DecodeError{Encoding: "ascii85", Offset: int(offset), Reason: fmt.Sprintf("invalid byte %#U", rune(input[offset]))}
+		}
+		return nil, err
+	}
+	return dst[:n], nil
+}
+
+// DeZ85 decodes a Z85 string (malformed input decodes to nil, see DecodeZ85)
+func DeZ85(input []byte) []byte {
+	return decodeOrNil(DecodeZ85, input)
+}
+
+// DecodeZ85 decodes a Z85 string (https://rfc.zeromq.org/spec/32/),
+// its length must be a multiple of 5.
+func DecodeZ85(input []byte) ([]byte, error) {
+	if rem := len(input) % 5; rem != 0 {
+		return nil, &DecodeError{Encoding: "z85", Offset: len(input) - rem, Reason: "length isn't a multiple of 5"}
+	}
+	out := make([]byte, 0, len(input)/5*4)
+	for i := 0; i < len(input); i += 5 {
+		var v uint64
+		for j, b := range input[i : i+5] {
+			d := bytes.IndexByte(z85Alphabet, b)
+			if d < 0 {
+				return nil, &DecodeError{Encoding: "z85", Offset: i + j, Reason: fmt.Sprintf("invalid byte %#U", rune(b))}
+			}
+			v = v*85 + uint64(d)
+		}
+		if v > math.MaxUint32 {
+			return nil, &DecodeError{Encoding: "z85", Offset: i, Reason: "block value overflow"}
+		}
+		out = append(out, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return out, nil
+}
+
+// DeBase64Alphabet returns a DataProcessFn decoding base64 strings encoded using a custom alphabet of 64 characters
+// (malformed input decodes to nil, see DecodeBase64Alphabet).
+func DeBase64Alphabet(alphabet string) DataProcessFn {
+	decode := DecodeBase64Alphabet(alphabet)
+	return func(input []byte) []byte {
+		return decodeOrNil(decode, input)
+	}
+}
+
+// DecodeBase64Alphabet returns a DataProcessErrFn decoding base64 strings encoded using a custom alphabet
+// of 64 characters, padded or not (unless the alphabet contains the padding character).
+// It panics if the alphabet isn't made of 64 distinct characters.
+func DecodeBase64Alphabet(alphabet string) DataProcessErrFn {
+	enc := base64.NewEncoding(alphabet).WithPadding(base64.NoPadding)
+	hasPadding := strings.IndexByte(alphabet, '=') >= 0
+	return func(input []byte) ([]byte, error) {
+		if !hasPadding {
+			input = trimPadding(input)
+		}
+		return decodeWith(enc, "base64", []byte(alphabet), input)
+	}
+}
+
+// RecoverBase64Alphabet recovers the custom alphabet used to base64 encode data starting with the known plaintext.
+// Each 6 bits of the known plaintext maps a character of the encoded data to its position in the alphabet.
+// The positions that can't be recovered are set to 0 in the returned alphabet and known is the number of
+// recovered characters. When a single position is missing and the encoded data uses a single unmapped character,
+// it is used for the missing position.
+// An error is returned if the plaintext doesn't match the encoded data.
+func RecoverBase64Alphabet(encoded, knownPlain []byte) (alphabet []byte, known int, err error) {
+	encoded = stripSpaces(encoded)
+	std := base64.RawStdEncoding.EncodeToString(knownPlain)
+	// the last character is incomplete, it depends on the following (unknown) plaintext bits
+	full := len(knownPlain) * 8 / 6
+	if full > len(encoded) {
+		return nil, 0, errors.New("kripto: known plaintext longer than the encoded data")
+	}
+
+	alphabet = make([]byte, 64)
+	positions := map[byte]int{}
+	for i := 0; i < full; i++ {
+		pos := bytes.IndexByte(base64Alphabet, std[i])
+		c := encoded[i]
+		if p, ok := positions[c]; ok && p != pos || alphabet[pos] != 0 && alphabet[pos] != c {
+			return nil, 0, fmt.Errorf("kripto: known plaintext doesn't match the encoded data at offset %d", i)
+		}
+		if alphabet[pos] == 0 {
+			alphabet[pos] = c
+			positions[c] = pos
+			known++
+		}
+	}
+
+	if known == 63 {
+		unmapped := []byte{}
+		for _, c := range encoded {
+			if _, ok := positions[c]; !ok && c != '=' && bytes.IndexByte(unmapped, c) < 0 {
+				unmapped = append(unmapped, c)
+			}
+		}
+		if len(unmapped) == 1 {
+			alphabet[bytes.IndexByte(alphabet, 0)] = unmapped[0]
+			known++
+		}
+	}
+	return alphabet, known, nil
+}
+
+var (
+	base32HexAlphabet = []byte("0123456789ABCDEFGHIJKLMNOPQRSTUV")
+	base58Alphabet    = []byte("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
+	z85Alphabet       = []byte("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#")
+)
+
+// mustDecode decodes the input and panics on errors.
+func mustDecode(decode DataProcessErrFn, input []byte) []byte {
+	out, err := decode(input)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// decodeOrNil decodes the input and swallows errors, malformed input decodes to nil.
+func decodeOrNil(decode DataProcessErrFn, input []byte) []byte {
+	out, err := decode(input)
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+// trimPadding removes the trailing padding characters (and white spaces).
+func trimPadding(input []byte) []byte {
+	return bytes.TrimRight(input, "= \t\r\n")
+}
+
+// baseEncoding is implemented by the base32 and base64 encodings
+type baseEncoding interface {
+	DecodedLen(n int) int
+	Decode(dst, src []byte) (int, error)
+}
+
+// decodeWith decodes the input, turning the corrupted input errors into *DecodeError.
+func decodeWith(enc baseEncoding, name string, alphabet, input []byte) ([]byte, error) {
 	dbuf := make([]byte, enc.DecodedLen(len(input)))
 	n, err := enc.Decode(dbuf, input)
 	if err != nil {
-		panic(err)
+		var offset int64
+		switch e := err.(type) {
+		case base64.CorruptInputError:
+			offset = int64(e)
+		case base32.CorruptInputError:
+			offset = int64(e)
+		default:
+			return nil, err
+		}
+		return nil, &DecodeError{Encoding: name, Offset: int(offset), Reason: corruptInputReason(input, int(offset), alphabet)}
+	}
+	return dbuf[:n], nil
+}
+
+// fromHexChar returns the value of a hex digit, -1 if b isn't one.
+func fromHexChar(b byte) int {
+	switch {
+	case b >= '0' && b <= '9':
+		return int(b - '0')
+	case b >= 'a' && b <= 'f':
+		return int(b-'a') + 10
+	case b >= 'A' && b <= 'F':
+		return int(b-'A') + 10
+	}
+	return -1
+}
+
+// corruptInputReason explains why a base32 or base64 decoder reported a corrupted input at offset.
+func corruptInputReason(input []byte, offset int, alphabet []byte) string {
+	switch {
+	case offset >= len(input):
+		return "truncated input"
+	case offset > 0 && input[offset-1] == '=':
+		return "data after padding"
+	case input[offset] == '=':
+		return "unexpected padding"
+	case bytes.IndexByte(alphabet, input[offset]) >= 0:
+		return "truncated input"
 	}
-	return dbuf[:n]
+	return fmt.Sprintf("invalid byte %#U", rune(input[offset]))
 }
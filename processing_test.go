@@ -0,0 +1,174 @@
+package kripto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeHex(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		err    *DecodeError
+	}{
+		{"", "", nil},
+		{"4372797074", "Crypt", nil},
+		{"4372797", "", &DecodeError{Encoding: "hex", Offset: 6, Reason: "odd length"}},
+		{"43727g7074", "", &DecodeError{Encoding: "hex", Offset: 5, Reason: "invalid byte U+0067 'g'"}},
+		{"4372 7970\r\n74", "Crypt", nil},
+		{"43 72\n7g", "", &DecodeError{Encoding: "hex", Offset: 7, Reason: "invalid byte U+0067 'g'"}},
+		{"43 72\n7", "", &DecodeError{Encoding: "hex", Offset: 6, Reason: "odd length"}},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, err := DecodeHex([]byte(tc.input))
+		if tc.err != nil {
+			if !reflect.DeepEqual(err, tc.err) {
+				t.Fatalf("expected error %v\ngot\n%v\n", tc.err, err)
+			}
+			continue
+		}
+		if err != nil || string(o) != tc.output {
+			t.Fatalf("expected '%s'\ngot\n'%s' (%v)\n", tc.output, o, err)
+		}
+	}
+}
+
+func TestDecodeBase64(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		err    *DecodeError
+	}{
+		{"", "", nil},
+		{"Q3J5cHRv", "Crypto", nil},
+		{"Q3J5cA==", "Cryp", nil},
+		{"Q3J5!HRv", "", &DecodeError{Encoding: "base64", Offset: 4, Reason: "invalid byte U+0021 '!'"}},
+		{"Q3J5cA", "", &DecodeError{Encoding: "base64", Offset: 4, Reason: "truncated input"}},
+		{"Q3=5cA==", "", &DecodeError{Encoding: "base64", Offset: 2, Reason: "unexpected padding"}},
+		{"Q3J5cA==Q3J5", "", &DecodeError{Encoding: "base64", Offset: 8, Reason: "data after padding"}},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, err := DecodeBase64([]byte(tc.input))
+		if tc.err != nil {
+			if !reflect.DeepEqual(err, tc.err) {
+				t.Fatalf("expected error %v\ngot\n%v\n", tc.err, err)
+			}
+			continue
+		}
+		if err != nil || string(o) != tc.output {
+			t.Fatalf("expected '%s'\ngot\n'%s' (%v)\n", tc.output, o, err)
+		}
+	}
+}
+
+func TestAdditionalDecoders(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		fn     DataProcessErrFn
+	}{
+		{"INZHS4DUN4======", "Crypto", DecodeBase32},
+		{"INZHS4DUN4", "Crypto", DecodeBase32},
+		{"8DP7IS3KDS======", "Crypto", DecodeBase32Hex},
+		{"8DP7IS3KDS", "Crypto", DecodeBase32Hex},
+		{"", "", DecodeBase58},
+		{"2g", "a", DecodeBase58},
+		{"a3gV", "bbb", DecodeBase58},
+		{"2cFupjhnEsSn59qHXstmK2ffpLv2", "simply a long string", DecodeBase58},
+		{"1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L", "\x00\xeb\x15\x23\x1d\xfc\xeb\x60\x92\x58\x86\xb6\x7d\x06\x52\x99\x92\x59\x15\xae\xb1\x72\xc0\x66\x47", DecodeBase58},
+		{"1111111111", "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00", DecodeBase58},
+		{"<~6ZRHkFDh~>", "Crypto", DecodeASCII85},
+		{"6ZRHkFDh", "Crypto", DecodeASCII85},
+		{"HelloWorld", "\x86\x4f\xd2\x6f\xb5\x59\xf7\x5b", DecodeZ85},
+		{"Q3J5cHRv", "Crypto", DecodeBase64Alphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/")},
+		{"q3j5ChrV", "Crypto", DecodeBase64Alphabet("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789+/")},
+		{"q3j5Ca==", "Cryp", DecodeBase64Alphabet("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789+/")},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if o, err := tc.fn([]byte(tc.input)); err != nil || string(o) != tc.output {
+			t.Fatalf("expected '%x'\ngot\n'%x' (%v)\n", tc.output, o, err)
+		}
+	}
+}
+
+func TestAdditionalDecodersErrors(t *testing.T) {
+	testCases := []struct {
+		input string
+		fn    DataProcessErrFn
+		err   *DecodeError
+	}{
+		{"INZHS4DUN1", DecodeBase32, &DecodeError{Encoding: "base32", Offset: 9, Reason: "invalid byte U+0031 '1'"}},
+		{"8DP7IS3KDW", DecodeBase32Hex, &DecodeError{Encoding: "base32hex", Offset: 9, Reason: "invalid byte U+0057 'W'"}},
+		{"2cFupjhnEsSn0", DecodeBase58, &DecodeError{Encoding: "base58", Offset: 12, Reason: "invalid byte U+0030 '0'"}},
+		{"HelloWorl", DecodeZ85, &DecodeError{Encoding: "z85", Offset: 5, Reason: "length isn't a multiple of 5"}},
+		{"Hello~orld", DecodeZ85, &DecodeError{Encoding: "z85", Offset: 5, Reason: "invalid byte U+007E '~'"}},
+		{"#####", DecodeZ85, &DecodeError{Encoding: "z85", Offset: 0, Reason: "block value overflow"}},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if _, err := tc.fn([]byte(tc.input)); !reflect.DeepEqual(err, tc.err) {
+			t.Fatalf("expected error %v\ngot\n%v\n", tc.err, err)
+		}
+	}
+}
+
+func TestAdditionalDecodersMalformed(t *testing.T) {
+	testCases := []struct {
+		input string
+		fn    DataProcessFn
+	}{
+		{"INZHS4DUN1", DeBase32},
+		{"8DP7IS3KDW", DeBase32Hex},
+		{"2cFupjhnEsSn0", DeBase58},
+		{"<~6ZRHkFvh~>", DeASCII85},
+		{"Hello~orld", DeZ85},
+		{"q3j5C!", DeBase64Alphabet("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789+/")},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if o := tc.fn([]byte(tc.input)); o != nil {
+			t.Fatalf("expected malformed input to decode to nil\ngot\n'%x'\n", o)
+		}
+	}
+}
+
+func TestRecoverBase64Alphabet(t *testing.T) {
+	// a shuffled alphabet like the ones found in malware
+	const custom = "ZYXWVUTSRQPONMLKJIHGFEDCBAzyxwvutsrqponmlkjihgfedcba9876543210+/"
+	enc := base64.NewEncoding(custom).WithPadding(base64.NoPadding)
+
+	known := make([]byte, 256)
+	for i := range known {
+		known[i] = byte(i)
+	}
+	secret := []byte("the configuration of the implant")
+	encoded := []byte(enc.EncodeToString(append(known, secret...)))
+
+	alphabet, n, err := RecoverBase64Alphabet(encoded, known)
+	if err != nil || n != 64 || string(alphabet) != custom {
+		t.Fatalf("expected to recover\n%s\nbut got\n%q (%d characters, %v)\n", custom, alphabet, n, err)
+	}
+	if o := DeBase64Alphabet(string(alphabet))(encoded); !bytes.Equal(o[len(known):], secret) {
+		t.Fatalf("expected to decode '%s' but got '%s'\n", secret, o[len(known):])
+	}
+
+	// a short known plaintext only recovers part of the alphabet
+	alphabet, n, err = RecoverBase64Alphabet(encoded, []byte{0, 1, 2})
+	if err != nil || n != 3 || alphabet[0] != 'Z' || alphabet[4] != 'V' || alphabet[2] != 'X' {
+		t.Fatalf("expected 3 characters but got %q (%d characters, %v)\n", alphabet, n, err)
+	}
+
+	if _, _, err := RecoverBase64Alphabet(encoded, []byte("not the plaintext")); err == nil {
+		t.Fatal("expected an error when the plaintext doesn't match")
+	}
+}
@@ -0,0 +1,258 @@
+package kripto
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// profileMagic starts the files written by LanguageProfile.WriteTo, followed by the format version
+var profileMagic = []byte("KPRF")
+
+// profileVersion is the version of the format written by LanguageProfile.WriteTo
+const profileVersion = 1
+
+// ErrInvalidProfile is returned when reading data that isn't a language profile.
+var ErrInvalidProfile = errors.New("kripto: invalid language profile")
+
+// LanguageProfile is a reference frequency profile built from sample text (a corpus).
+// It can be any kind of text: a natural language, source code, JSON...
+//
+// LanguageProfile implements CharMapScorer, scoring how close a char map is to the profile's
+// character distribution (using the Bhattacharyya distance), and TextScorer, scoring the text
+// based on the profile's n-grams (see NGramScorer).
+type LanguageProfile struct {
+	// Name identifies the profile (for instance the language).
+	Name string
+	// N is the length of the n-grams tracked by the profile (between 1 and 4).
+	N int
+	// Unigrams is the usage of each character in the corpus, letters are lowercased
+	// the same way NewCharMap does it.
+	Unigrams CharUseMap
+	// NGrams counts the n-grams of the corpus (normalized the way NGramScorer does it).
+	NGrams map[string]int
+
+	// ngrams is the n-gram scorer built from NGrams when the profile is trained or read,
+	// so a profile can score texts from several goroutines.
+	ngrams *NGramScorer
+}
+
+// NewLanguageProfile returns an empty profile tracking n-grams of n characters.
+func NewLanguageProfile(name string, n int) *LanguageProfile {
+	if n < 1 || n > 4 {
+		panic("kripto: n-gram length must be between 1 and 4")
+	}
+	return &LanguageProfile{
+		Name:     name,
+		N:        n,
+		Unigrams: CharUseMap{},
+		NGrams:   map[string]int{},
+	}
+}
+
+// Train adds the corpus to the profile statistics.
+// Train can be called multiple times to build a profile from multiple samples.
+func (p *LanguageProfile) Train(corpus []byte) {
+	for b, stats := range *NewCharMap(corpus) {
+		if s, ok := p.Unigrams[b]; ok {
+			s.Count += stats.Count
+			s.UpperCount += stats.UpperCount
+			continue
+		}
+		p.Unigrams[b] = &CharStats{Count: stats.Count, UpperCount: stats.UpperCount}
+	}
+	p.Unigrams.updateFreqs()
+
+	seq := ngramSymbols(corpus)
+	// consecutive white spaces are layout, not language
+	collapsed := seq[:0]
+	for _, b := range seq {
+		if b == ' ' && len(collapsed) > 0 && collapsed[len(collapsed)-1] == ' ' {
+			continue
+		}
+		collapsed = append(collapsed, b)
+	}
+	for i := 0; i+p.N <= len(collapsed); i++ {
+		p.NGrams[string(collapsed[i:i+p.N])]++
+	}
+	p.ngrams = NewNGramScorer(p.N, p.NGrams)
+}
+
+// TrainFile adds the content of the file at path to the profile statistics.
+func (p *LanguageProfile) TrainFile(path string) error {
+	corpus, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	p.Train(corpus)
+	return nil
+}
+
+// Score implements the CharMapScorer interface
+func (p *LanguageProfile) Score(m *CharUseMap) float64 {
+	return -BhattacharyyaDistance(m, &p.Unigrams, 0)
+}
+
+// ScoreText implements the TextScorer interface
+func (p *LanguageProfile) ScoreText(text []byte) float64 {
+	if p.ngrams == nil {
+		// the profile wasn't trained or read, NGrams was filled by hand
+		return NewNGramScorer(p.N, p.NGrams).ScoreText(text)
+	}
+	return p.ngrams.ScoreText(text)
+}
+
+// WriteTo writes the profile to w using a compact binary format
+// that can be read back using ReadLanguageProfile.
+// It implements the io.WriterTo interface.
+func (p *LanguageProfile) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	cw := &countWriter{w: bw}
+	buf := make([]byte, binary.MaxVarintLen64)
+	putUvarint := func(v uint64) {
+		cw.Write(buf[:binary.PutUvarint(buf, v)])
+	}
+
+	cw.Write(profileMagic)
+	cw.Write([]byte{profileVersion})
+	putUvarint(uint64(len(p.Name)))
+	cw.Write([]byte(p.Name))
+	putUvarint(uint64(p.N))
+
+	putUvarint(uint64(len(p.Unigrams)))
+	for i := 0; i < 256; i++ {
+		if stats, ok := p.Unigrams[byte(i)]; ok {
+			cw.Write([]byte{byte(i)})
+			putUvarint(uint64(stats.Count))
+			putUvarint(uint64(stats.UpperCount))
+		}
+	}
+
+	grams := make([]string, 0, len(p.NGrams))
+	for gram := range p.NGrams {
+		if len(gram) == p.N {
+			grams = append(grams, gram)
+		}
+	}
+	// sorted so a profile is always written the same way
+	sort.Strings(grams)
+	putUvarint(uint64(len(grams)))
+	for _, gram := range grams {
+		cw.Write([]byte(gram))
+		putUvarint(uint64(p.NGrams[gram]))
+	}
+
+	if cw.err == nil {
+		cw.err = bw.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// Save writes the profile to the file at path.
+func (p *LanguageProfile) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := p.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadLanguageProfile reads a profile written by LanguageProfile.WriteTo.
+func ReadLanguageProfile(r io.Reader) (*LanguageProfile, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(profileMagic)+1)
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic[:len(profileMagic)]) != string(profileMagic) {
+		return nil, ErrInvalidProfile
+	}
+	if magic[len(profileMagic)] != profileVersion {
+		return nil, ErrInvalidProfile
+	}
+
+	nameLen, err := binary.ReadUvarint(br)
+	if err != nil || nameLen > 1<<16 {
+		return nil, ErrInvalidProfile
+	}
+	name := make([]byte, nameLen)
+	if _, err := io.ReadFull(br, name); err != nil {
+		return nil, ErrInvalidProfile
+	}
+	n, err := binary.ReadUvarint(br)
+	if err != nil || n < 1 || n > 4 {
+		return nil, ErrInvalidProfile
+	}
+	p := NewLanguageProfile(string(name), int(n))
+
+	unigrams, err := binary.ReadUvarint(br)
+	if err != nil || unigrams > 256 {
+		return nil, ErrInvalidProfile
+	}
+	for i := uint64(0); i < unigrams; i++ {
+		b, err := br.ReadByte()
+		if err != nil {
+			return nil, ErrInvalidProfile
+		}
+		count, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, ErrInvalidProfile
+		}
+		upper, err := binary.ReadUvarint(br)
+		if err != nil || upper > count {
+			return nil, ErrInvalidProfile
+		}
+		p.Unigrams[b] = &CharStats{Count: float64(count), UpperCount: float64(upper)}
+	}
+	p.Unigrams.updateFreqs()
+
+	grams, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, ErrInvalidProfile
+	}
+	gram := make([]byte, p.N)
+	for i := uint64(0); i < grams; i++ {
+		if _, err := io.ReadFull(br, gram); err != nil {
+			return nil, ErrInvalidProfile
+		}
+		count, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, ErrInvalidProfile
+		}
+		p.NGrams[string(gram)] = int(count)
+	}
+	p.ngrams = NewNGramScorer(p.N, p.NGrams)
+	return p, nil
+}
+
+// LoadLanguageProfile reads the profile saved in the file at path.
+func LoadLanguageProfile(path string) (*LanguageProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadLanguageProfile(f)
+}
+
+// countWriter counts the bytes written and keeps the first error.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
@@ -0,0 +1,144 @@
+package kripto
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func trainedProfile(t *testing.T, name string, files ...string) *LanguageProfile {
+	p := NewLanguageProfile(name, 3)
+	for _, file := range files {
+		if err := p.TrainFile(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return p
+}
+
+func TestLanguageProfileScore(t *testing.T) {
+	english := trainedProfile(t, "english", fixturePath("english.txt"))
+	golang := trainedProfile(t, "go", fixturePath("golang.txt"))
+
+	testCases := []struct {
+		input    string
+		expected *LanguageProfile
+	}{
+		{"The distance between repetitions tells us how long the key is.", english},
+		{"for i, b := range data {\n\tif b != key[i] {\n\t\treturn nil, err\n\t}\n}", golang},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		winner := english
+		if golang.ScoreText([]byte(tc.input)) > english.ScoreText([]byte(tc.input)) {
+			winner = golang
+		}
+		if winner != tc.expected {
+			t.Fatalf("expected the %s profile to win the n-gram score, got %s", tc.expected.Name, winner.Name)
+		}
+		m := NewCharMap([]byte(tc.input))
+		winner = english
+		if golang.Score(m) > english.Score(m) {
+			winner = golang
+		}
+		if winner != tc.expected {
+			t.Fatalf("expected the %s profile to win the char map score, got %s", tc.expected.Name, winner.Name)
+		}
+	}
+}
+
+func TestLanguageProfileBreakSingleCharXor(t *testing.T) {
+	golang := trainedProfile(t, "go", fixturePath("golang.txt"))
+	code := "func (s *ByteKeyStats) Less(i, j int) bool {\n\treturn s[i].Score > s[j].Score\n}"
+	o, k := BreakSingleCharXor(SingleCharXor([]byte(code), 0x9c), nil, golang)
+	if string(o) != code || k != 0x9c {
+		t.Fatalf("expected to get:\n'%s' using key %x\nbut got\n'%s'\n from key %x\n", code, 0x9c, o, k)
+	}
+}
+
+func TestLanguageProfileConcurrentScoreText(t *testing.T) {
+	golang := trainedProfile(t, "go", fixturePath("golang.txt"))
+	code := []byte("for i, b := range data {")
+
+	scores := make([]float64, 8)
+	var wg sync.WaitGroup
+	for i := range scores {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scores[i] = golang.ScoreText(code)
+		}(i)
+	}
+	wg.Wait()
+	expected := NewNGramScorer(golang.N, golang.NGrams).ScoreText(code)
+	for i, score := range scores {
+		if score != expected {
+			t.Fatalf("expected goroutine %d to score %f but got %f\n", i, expected, score)
+		}
+	}
+}
+
+func TestLanguageProfileReadWrite(t *testing.T) {
+	p := trainedProfile(t, "english", fixturePath("english.txt"))
+
+	buf := &bytes.Buffer{}
+	n, err := p.WriteTo(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(buf.Len()) {
+		t.Fatalf("expected %d bytes to be reported as written, got %d", buf.Len(), n)
+	}
+	dir, err := ioutil.TempDir("", "kripto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "english.kprf")
+	if err := p.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	fromFile, err := LoadLanguageProfile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromBuf, err := ReadLanguageProfile(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, loaded := range []*LanguageProfile{fromFile, fromBuf} {
+		if loaded.Name != p.Name || loaded.N != p.N {
+			t.Fatalf("expected %s/%d, got %s/%d", p.Name, p.N, loaded.Name, loaded.N)
+		}
+		if !reflect.DeepEqual(loaded.NGrams, p.NGrams) {
+			t.Fatal("n-grams weren't properly loaded")
+		}
+		if len(loaded.Unigrams) != len(p.Unigrams) {
+			t.Fatalf("expected %d unigrams, got %d", len(p.Unigrams), len(loaded.Unigrams))
+		}
+		for b, stats := range p.Unigrams {
+			if loaded.Unigrams[b] == nil || loaded.Unigrams[b].Count != stats.Count || loaded.Unigrams[b].UpperCount != stats.UpperCount {
+				t.Fatalf("unigram %q wasn't properly loaded", b)
+			}
+		}
+	}
+
+	if p.Unigrams['t'].UpperCount == 0 {
+		t.Fatal("expected the corpus to have uppercase letters")
+	}
+
+	if _, err := ReadLanguageProfile(bytes.NewReader([]byte("not a profile"))); err != ErrInvalidProfile {
+		t.Fatalf("expected ErrInvalidProfile, got %v", err)
+	}
+	full := &bytes.Buffer{}
+	p.WriteTo(full)
+	if _, err := ReadLanguageProfile(bytes.NewReader(full.Bytes()[:full.Len()/2])); err != ErrInvalidProfile {
+		t.Fatalf("expected ErrInvalidProfile, got %v", err)
+	}
+}
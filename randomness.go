@@ -0,0 +1,228 @@
+package kripto
+
+import (
+	"fmt"
+	"math"
+)
+
+// RandomnessReport describes how random a blob of data looks,
+// similar to what the ent tool reports (http://www.fourmilab.ch/random/).
+// Plain text has a low entropy and fails all the tests, encoded data (hex, base64...)
+// has a limited alphabet and a medium entropy, compressed and encrypted data look random.
+type RandomnessReport struct {
+	// Len is the number of analyzed bytes.
+	Len int
+	// Entropy is the Shannon entropy in bits per byte (8 for random data).
+	Entropy float64
+	// ChiSquared is the chi-squared statistic of the byte counts against a uniform distribution.
+	ChiSquared float64
+	// ChiSquaredPValue is the probability of a uniform distribution giving a statistic at least as high,
+	// values below 0.01 or above 0.99 indicate the data isn't random.
+	ChiSquaredPValue float64
+	// Mean is the arithmetic mean of the bytes (127.5 for random data).
+	Mean float64
+	// MonteCarloPi is the value of pi estimated by using each 6 bytes as 24 bit X and Y coordinates
+	// in a square and counting the points that are within the inscribed circle.
+	MonteCarloPi float64
+	// SerialCorrelation is the correlation coefficient of each byte with the next one
+	// (close to 0 for random data, close to 1 for slowly changing data such as text).
+	SerialCorrelation float64
+	// Runs is the number of uninterrupted sequences of identical bits.
+	Runs int
+	// RunsPValue is the p-value of the runs test (NIST SP 800-22 section 2.3),
+	// values below 0.01 indicate the bits oscillate too fast or too slowly to be random.
+	RunsPValue float64
+}
+
+func (r *RandomnessReport) String() string {
+	return fmt.Sprintf("Entropy = %f bits per byte.\n"+
+		"Chi square distribution for %d samples is %0.2f, and randomly would exceed this value %0.2f percent of the times.\n"+
+		"Arithmetic mean value of data bytes is %0.4f (127.5 = random).\n"+
+		"Monte Carlo value for Pi is %0.9f (error %0.2f percent).\n"+
+		"Serial correlation coefficient is %f (totally uncorrelated = 0.0).\n"+
+		"Runs test found %d runs, p-value %f.\n",
+		r.Entropy, r.Len, r.ChiSquared, r.ChiSquaredPValue*100, r.Mean,
+		r.MonteCarloPi, 100*math.Abs(math.Pi-r.MonteCarloPi)/math.Pi,
+		r.SerialCorrelation, r.Runs, r.RunsPValue)
+}
+
+// LooksRandom indicates if the data passed the randomness tests
+// (its entropy is close to 8 bits per byte and the chi-squared and runs tests don't reject it).
+func (r *RandomnessReport) LooksRandom() bool {
+	return r.Entropy > 7.5 && r.ChiSquaredPValue > 0.001 && r.ChiSquaredPValue < 0.999 && r.RunsPValue > 0.001
+}
+
+// AnalyzeRandomness runs the randomness tests over the data.
+func AnalyzeRandomness(data []byte) *RandomnessReport {
+	r := &RandomnessReport{Len: len(data)}
+	if len(data) == 0 {
+		return r
+	}
+
+	var counts [256]float64
+	var sum float64
+	for _, b := range data {
+		counts[b]++
+		sum += float64(b)
+	}
+	n := float64(len(data))
+	r.Mean = sum / n
+
+	expected := n / 256
+	r.Entropy = ShannonEntropy(data)
+	for _, count := range counts {
+		d := count - expected
+		r.ChiSquared += d * d / expected
+	}
+	r.ChiSquaredPValue = chiSquaredSurvival(r.ChiSquared, 255)
+
+	r.MonteCarloPi = monteCarloPi(data)
+	r.SerialCorrelation = serialCorrelation(data)
+	r.Runs, r.RunsPValue = runsTest(data)
+	return r
+}
+
+// ShannonEntropy returns the Shannon entropy of the raw bytes of the data in bits per byte,
+// from 0 for a repeated byte to 8 for uniformly distributed bytes.
+func ShannonEntropy(data []byte) float64 {
+	var counts [256]float64
+	for _, b := range data {
+		counts[b]++
+	}
+	var entropy float64
+	n := float64(len(data))
+	for _, count := range counts {
+		if count > 0 {
+			p := count / n
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy
+}
+
+// monteCarloPi estimates pi the same way ent does: each 6 bytes are used as the 24 bit
+// coordinates of a point in a square, the ratio of points inside the inscribed circle is pi/4.
+func monteCarloPi(data []byte) float64 {
+	const radius = 256.0*256.0*256.0 - 1
+	var points, inside float64
+	for i := 0; i+6 <= len(data); i += 6 {
+		var x, y float64
+		for j := 0; j < 3; j++ {
+			x = x*256 + float64(data[i+j])
+			y = y*256 + float64(data[i+3+j])
+		}
+		points++
+		if x*x+y*y <= radius*radius {
+			inside++
+		}
+	}
+	if points == 0 {
+		return 0
+	}
+	return 4 * inside / points
+}
+
+// serialCorrelation returns the correlation coefficient of each byte with the next one,
+// the last byte being compared with the first one (like ent does).
+func serialCorrelation(data []byte) float64 {
+	n := float64(len(data))
+	var t1, t2, t3 float64
+	for i, b := range data {
+		u := float64(b)
+		next := float64(data[(i+1)%len(data)])
+		t1 += u * next
+		t2 += u
+		t3 += u * u
+	}
+	denom := n*t3 - t2*t2
+	if denom == 0 {
+		// constant data
+		return 1
+	}
+	return (n*t1 - t2*t2) / denom
+}
+
+// runsTest counts the runs of identical bits and returns the p-value of the runs test
+// described in NIST SP 800-22.
+func runsTest(data []byte) (int, float64) {
+	n := float64(len(data) * 8)
+	var ones float64
+	runs := 1
+	var prev byte
+	for i, b := range data {
+		ones += float64(bitsTable[b])
+		for j := 7; j >= 0; j-- {
+			bit := (b >> uint(j)) & 1
+			if (i > 0 || j < 7) && bit != prev {
+				runs++
+			}
+			prev = bit
+		}
+	}
+
+	pi := ones / n
+	// the frequency test prerequisite
+	if math.Abs(pi-0.5) >= 2/math.Sqrt(n) {
+		return runs, 0
+	}
+	num := math.Abs(float64(runs) - 2*n*pi*(1-pi))
+	return runs, math.Erfc(num / (2 * math.Sqrt(2*n) * pi * (1 - pi)))
+}
+
+// chiSquaredSurvival returns the probability for a chi-squared distribution with the given
+// degrees of freedom to be greater than x.
+func chiSquaredSurvival(x float64, degrees int) float64 {
+	return upperIncompleteGamma(float64(degrees)/2, x/2)
+}
+
+// upperIncompleteGamma returns the regularized upper incomplete gamma function Q(a, x),
+// using a series when x < a+1 and a continued fraction otherwise.
+func upperIncompleteGamma(a, x float64) float64 {
+	const (
+		maxIterations = 500
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+	if x <= 0 {
+		return 1
+	}
+	lgamma, _ := math.Lgamma(a)
+	prefix := math.Exp(a*math.Log(x) - x - lgamma)
+
+	if x < a+1 {
+		sum, term := 1/a, 1/a
+		for i := 1; i < maxIterations; i++ {
+			term *= x / (a + float64(i))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*epsilon {
+				break
+			}
+		}
+		return math.Max(0, 1-sum*prefix)
+	}
+
+	// modified Lentz's method
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < maxIterations; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return prefix * h
+}
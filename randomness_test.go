@@ -0,0 +1,102 @@
+package kripto
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAnalyzeRandomness(t *testing.T) {
+	random := make([]byte, 1<<17)
+	rand.New(rand.NewSource(1)).Read(random)
+	text, err := ioutil.ReadFile(fixturePath("english.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := []byte(base64.StdEncoding.EncodeToString(random))
+
+	r := AnalyzeRandomness(random)
+	t.Logf("random data:\n%s", r)
+	if !r.LooksRandom() {
+		t.Fatal("expected random data to look random")
+	}
+	if r.Entropy < 7.99 {
+		t.Fatalf("expected an entropy close to 8, got %f", r.Entropy)
+	}
+	if math.Abs(r.MonteCarloPi-math.Pi) > 0.05 {
+		t.Fatalf("expected pi to be properly estimated, got %f", r.MonteCarloPi)
+	}
+	if math.Abs(r.SerialCorrelation) > 0.01 {
+		t.Fatalf("expected no serial correlation, got %f", r.SerialCorrelation)
+	}
+	if math.Abs(r.Mean-127.5) > 1 {
+		t.Fatalf("expected a mean close to 127.5, got %f", r.Mean)
+	}
+
+	for name, data := range map[string][]byte{"text": text, "base64": encoded} {
+		r := AnalyzeRandomness(data)
+		t.Logf("%s:\n%s", name, r)
+		if r.LooksRandom() {
+			t.Fatalf("expected %s not to look random", name)
+		}
+		if r.Entropy > 6.01 {
+			t.Fatalf("expected %s to have a lower entropy, got %f", name, r.Entropy)
+		}
+	}
+
+	if r := AnalyzeRandomness(nil); r.Len != 0 || r.LooksRandom() {
+		t.Fatal("expected empty data not to look random")
+	}
+}
+
+func TestChiSquaredSurvival(t *testing.T) {
+	testCases := []struct {
+		x       float64
+		degrees int
+		p       float64
+	}{
+		{0, 255, 1},
+		// critical values
+		{293.248, 255, 0.05},
+		{310.457, 255, 0.01},
+		{3.841, 1, 0.05},
+		{18.307, 10, 0.05},
+		{2.558, 10, 0.99},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if p := chiSquaredSurvival(tc.x, tc.degrees); math.Abs(p-tc.p) > 1e-3 {
+			t.Fatalf("expected %f\ngot\n%f\n", tc.p, p)
+		}
+	}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+
+	testCases := []struct {
+		input   []byte
+		entropy float64
+	}{
+		{[]byte{}, 0},
+		{[]byte("aaaa"), 0},
+		{[]byte("abab"), 1},
+		{[]byte{0, 1, 2, 3, 4, 5, 6, 7}, 3},
+		// case is preserved
+		{[]byte("aAbB"), 2},
+		{all, 8},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		if e := ShannonEntropy(tc.input); math.Abs(e-tc.entropy) > 1e-9 {
+			t.Fatalf("expected %f\ngot\n%f\n", tc.entropy, e)
+		}
+	}
+}
@@ -16,3 +16,25 @@ type EnglishScorer struct {
 func (s *EnglishScorer) Score(m *CharUseMap) float64 {
 	return m.EnglishScore(s.WithSpace)
 }
+
+// TextScorer scores raw text, unlike CharMapScorer it can look at the order of the characters.
+// The breaking functions use ScoreText instead of Score when their CharMapScorer also implements TextScorer.
+type TextScorer interface {
+	ScoreText(text []byte) float64
+}
+
+// scoreText scores the text using the scorer, as a TextScorer when possible.
+func scoreText(scorer CharMapScorer, text []byte, m *CharUseMap) float64 {
+	if ts, ok := scorer.(TextScorer); ok {
+		return ts.ScoreText(text)
+	}
+	return scorer.Score(m)
+}
+
+// ASCIIScorer score a character map based on how much it looks like ASCII text (see CharUseMap.ASCIIScore).
+type ASCIIScorer struct{}
+
+// Score implements the CharMapScorer interface
+func (s *ASCIIScorer) Score(m *CharUseMap) float64 {
+	return m.ASCIIScore()
+}
@@ -0,0 +1,167 @@
+package kripto
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// streamBufferSize is the size of the buffers used by the stream wrappers
+const streamBufferSize = 32 * 1024
+
+// xorStream xors data with a repeating key, keeping track of the key offset between calls.
+type xorStream struct {
+	key    []byte
+	offset int
+}
+
+func newXorStream(key []byte) xorStream {
+	if len(key) == 0 {
+		panic("kripto: empty xor key")
+	}
+	return xorStream{key: append([]byte{}, key...)}
+}
+
+// xor xors src into dst (which can be src) without moving the key offset.
+func (x *xorStream) xor(dst, src []byte) {
+	kIDX := x.offset
+	for i, b := range src {
+		dst[i] = b ^ x.key[kIDX]
+		kIDX++
+		if kIDX == len(x.key) {
+			kIDX = 0
+		}
+	}
+}
+
+func (x *xorStream) advance(n int) {
+	x.offset = (x.offset + n) % len(x.key)
+}
+
+type xorReader struct {
+	r io.Reader
+	xorStream
+}
+
+// NewXorReader returns a reader xoring the data read from r using a repeating key
+// (see MultiCharXor, a single character key works like SingleCharXor).
+func NewXorReader(r io.Reader, key []byte) io.Reader {
+	return &xorReader{r: r, xorStream: newXorStream(key)}
+}
+
+func (x *xorReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	x.xor(p[:n], p[:n])
+	x.advance(n)
+	return n, err
+}
+
+type xorWriter struct {
+	w   io.Writer
+	buf []byte
+	xorStream
+}
+
+// NewXorWriter returns a writer xoring the data written to it using a repeating key before writing it to w.
+// The key offset is kept between calls to Write, so writing the data in multiple chunks gives the same result
+// as MultiCharXor.
+func NewXorWriter(w io.Writer, key []byte) io.Writer {
+	return &xorWriter{w: w, buf: make([]byte, streamBufferSize), xorStream: newXorStream(key)}
+}
+
+func (x *xorWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > len(x.buf) {
+			chunk = chunk[:len(x.buf)]
+		}
+		x.xor(x.buf, chunk)
+		n, err := x.w.Write(x.buf[:len(chunk)])
+		x.advance(n)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		if n < len(chunk) {
+			return written, io.ErrShortWrite
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+type hexReader struct {
+	r   io.Reader
+	buf []byte
+	// half is the value of the first digit of a byte split between two reads, -1 if none
+	half int
+	// offset is the number of bytes read from r
+	offset int
+	err    error
+}
+
+// NewDeHexReader returns a reader decoding the hex data read from r.
+// White spaces are ignored, malformed data returns a *DecodeError (see DecodeHex).
+func NewDeHexReader(r io.Reader) io.Reader {
+	return &hexReader{r: r, buf: make([]byte, streamBufferSize), half: -1}
+}
+
+func (h *hexReader) Read(p []byte) (int, error) {
+	n := 0
+	for n == 0 && len(p) > 0 && h.err == nil {
+		// each output byte takes at least 2 input bytes
+		size := 2 * len(p)
+		if size > len(h.buf) {
+			size = len(h.buf)
+		}
+		m, err := h.r.Read(h.buf[:size])
+		for _, b := range h.buf[:m] {
+			h.offset++
+			switch b {
+			case ' ', '\t', '\r', '\n':
+				continue
+			}
+			v := fromHexChar(b)
+			if v < 0 {
+				h.err = &DecodeError{Encoding: "hex", Offset: h.offset - 1, Reason: fmt.Sprintf("invalid byte %#U", rune(b))}
+				break
+			}
+			if h.half < 0 {
+				h.half = v
+				continue
+			}
+			p[n] = byte(h.half<<4 | v)
+			n++
+			h.half = -1
+		}
+		if err != nil && h.err == nil {
+			h.err = err
+			if err == io.EOF && h.half >= 0 {
+				h.err = &DecodeError{Encoding: "hex", Offset: h.offset - 1, Reason: "odd length"}
+			}
+		}
+	}
+	if n > 0 {
+		return n, nil
+	}
+	return 0, h.err
+}
+
+// NewHexWriter returns a writer hex encoding the data written to it before writing it to w.
+func NewHexWriter(w io.Writer) io.Writer {
+	return hex.NewEncoder(w)
+}
+
+// NewDeBase64Reader returns a reader decoding the standard base64 data read from r,
+// new lines are ignored.
+func NewDeBase64Reader(r io.Reader) io.Reader {
+	return base64.NewDecoder(base64.StdEncoding, r)
+}
+
+// NewBase64Writer returns a writer base64 encoding the data written to it before writing it to w.
+// The writer must be closed to flush the last partial block.
+func NewBase64Writer(w io.Writer) io.WriteCloser {
+	return base64.NewEncoder(base64.StdEncoding, w)
+}
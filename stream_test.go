@@ -0,0 +1,98 @@
+package kripto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestXorWriter(t *testing.T) {
+	plain := []byte("Burning 'em, if you ain't quick and nimble\nI go crazy when I hear a cymbal")
+	key := []byte("ICE")
+	expected := MultiCharXor(plain, key)
+
+	for _, chunkSize := range []int{1, 2, 5, 7, len(plain)} {
+		t.Logf("chunk size %d\n", chunkSize)
+		var buf bytes.Buffer
+		w := NewXorWriter(&buf, key)
+		for i := 0; i < len(plain); i += chunkSize {
+			end := i + chunkSize
+			if end > len(plain) {
+				end = len(plain)
+			}
+			if _, err := w.Write(plain[i:end]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Fatalf("expected\n%x\ngot\n%x\n", expected, buf.Bytes())
+		}
+	}
+}
+
+func TestXorReader(t *testing.T) {
+	plain := []byte("Burning 'em, if you ain't quick and nimble\nI go crazy when I hear a cymbal")
+	key := []byte("ICE")
+
+	o, err := ioutil.ReadAll(NewXorReader(iotest.HalfReader(bytes.NewReader(MultiCharXor(plain, key))), key))
+	if err != nil || !bytes.Equal(o, plain) {
+		t.Fatalf("expected\n%s\ngot\n%s (%v)\n", plain, o, err)
+	}
+}
+
+func TestDeHexReader(t *testing.T) {
+	plain := []byte("Cooking MC's like a pound of bacon")
+	encoded := hex.EncodeToString(plain)
+	wrapped := encoded[:21] + "\n" + encoded[21:50] + "\r\n" + encoded[50:]
+
+	o, err := ioutil.ReadAll(NewDeHexReader(iotest.OneByteReader(strings.NewReader(wrapped))))
+	if err != nil || !bytes.Equal(o, plain) {
+		t.Fatalf("expected\n%s\ngot\n%s (%v)\n", plain, o, err)
+	}
+
+	testCases := []struct {
+		input  string
+		output string
+		err    *DecodeError
+	}{
+		{"436f6f\nkin", "Coo", &DecodeError{Encoding: "hex", Offset: 7, Reason: "invalid byte U+006B 'k'"}},
+		{"436f6f6", "Coo", &DecodeError{Encoding: "hex", Offset: 6, Reason: "odd length"}},
+	}
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		o, err := ioutil.ReadAll(NewDeHexReader(strings.NewReader(tc.input)))
+		if string(o) != tc.output || !reflect.DeepEqual(err, tc.err) {
+			t.Fatalf("expected '%s' and %v\ngot\n'%s' and %v\n", tc.output, tc.err, o, err)
+		}
+	}
+}
+
+func TestStreamPipeline(t *testing.T) {
+	plain := bytes.Repeat([]byte("Crypto is fun, isn't it? "), 5000)
+	key := []byte("YELLOW")
+
+	// plain -> xor -> hex -> base64
+	var encoded bytes.Buffer
+	b64 := NewBase64Writer(&encoded)
+	w := NewXorWriter(NewHexWriter(b64), key)
+	for i := 0; i < len(plain); i += 1000 {
+		if _, err := w.Write(plain[i : i+1000]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b64.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if o, chain := PeelEncodings(encoded.Bytes(), maxEncodingLayers); len(chain) != 2 || !bytes.Equal(o, MultiCharXor(plain, key)) {
+		t.Fatalf("expected the base64 encoding of the hex encoded xored data but got %v\n", chain)
+	}
+
+	o, err := ioutil.ReadAll(NewXorReader(NewDeHexReader(NewDeBase64Reader(&encoded)), key))
+	if err != nil || !bytes.Equal(o, plain) {
+		t.Fatalf("failed to decode the stream: %v\n", err)
+	}
+}
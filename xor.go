@@ -1,6 +1,9 @@
 package kripto
 
-import "sort"
+import (
+	"math"
+	"sort"
+)
 
 // DataProcessFn offers a generic interface to process input data
 type DataProcessFn func(data []byte) []byte
@@ -18,28 +21,86 @@ func SingleCharXor(encStr []byte, k byte) []byte {
 // The processFn param is a function that can be used to apply basic input processing (hex/base64 decoding for instance).
 // The scorer param is used to score the output data and find the right key.
 func BreakSingleCharXor(xord []byte, processFn DataProcessFn, scorer CharMapScorer) (out []byte, key byte) {
+	stats := BreakSingleCharXorCandidates(xord, processFn, scorer, 1)
+	if len(stats) == 0 {
+		return
+	}
+	return stats[0].Text, stats[0].Key
+}
+
+// BreakSingleCharXorCandidates tries all 256 possible keys and returns the n best candidates
+// ranked by score (all of them if n isn't positive).
+// See BreakSingleCharXor for the processFn and scorer params.
+func BreakSingleCharXorCandidates(xord []byte, processFn DataProcessFn, scorer CharMapScorer, n int) ByteKeyColStats {
 	if processFn != nil {
 		xord = processFn(xord)
 	}
+	return singleCharXorCandidates(xord, scorer, n)
+}
 
-	stats := ByteKeyColStats{}
-	for k := byte(0); k < 255; k++ {
-		text := SingleCharXor(xord, k)
+// BreakSingleCharXorErr works like BreakSingleCharXor but returns the error of processFn
+// instead of trying to break data that couldn't be processed (malformed hex for instance).
+func BreakSingleCharXorErr(xord []byte, processFn DataProcessErrFn, scorer CharMapScorer) (out []byte, key byte, err error) {
+	stats, err := BreakSingleCharXorCandidatesErr(xord, processFn, scorer, 1)
+	if err != nil || len(stats) == 0 {
+		return nil, 0, err
+	}
+	return stats[0].Text, stats[0].Key, nil
+}
+
+// BreakSingleCharXorCandidatesErr works like BreakSingleCharXorCandidates but returns the error of processFn.
+func BreakSingleCharXorCandidatesErr(xord []byte, processFn DataProcessErrFn, scorer CharMapScorer, n int) (ByteKeyColStats, error) {
+	if processFn != nil {
+		var err error
+		if xord, err = processFn(xord); err != nil {
+			return nil, err
+		}
+	}
+	return singleCharXorCandidates(xord, scorer, n), nil
+}
+
+// singleCharXorCandidates tries all 256 possible keys and returns the n best candidates.
+func singleCharXorCandidates(xord []byte, scorer CharMapScorer, n int) ByteKeyColStats {
+	stats := make(ByteKeyColStats, 0, 256)
+	for k := 0; k < 256; k++ {
+		text := SingleCharXor(xord, byte(k))
 		m := NewCharMap(text)
 		stats = append(stats, &ByteKeyStats{
 			CharMap: m,
-			Score:   scorer.Score(m),
+			Score:   scoreText(scorer, text, m),
 			Text:    text,
-			Key:     k,
+			Key:     byte(k),
 		})
 	}
 
-	if len(stats) == 0 {
-		return
+	// stable so the lowest key wins ties
+	sort.Stable(stats)
+	if n > 0 && n < len(stats) {
+		stats = stats[:n]
 	}
+	return stats
+}
 
-	sort.Sort(stats)
-	return stats[0].Text, stats[0].Key
+// DetectSingleCharXor finds the lines that were most likely encrypted using a single character xor key.
+// Each line is broken using BreakSingleCharXorCandidates and the lines are returned ranked by the score
+// of their best key (only the n best lines are returned, or all of them if n isn't positive).
+// See BreakSingleCharXor for the processFn and scorer params.
+func DetectSingleCharXor(lines [][]byte, processFn DataProcessFn, scorer CharMapScorer, n int) LineKeyColStats {
+	stats := make(LineKeyColStats, 0, len(lines))
+	for i, line := range lines {
+		best := BreakSingleCharXorCandidates(line, processFn, scorer, 1)
+		if len(best) == 0 {
+			continue
+		}
+		stats = append(stats, &LineKeyStats{ByteKeyStats: best[0], Line: i})
+	}
+
+	// stable so the first line wins ties
+	sort.Stable(stats)
+	if n > 0 && n < len(stats) {
+		stats = stats[:n]
+	}
+	return stats
 }
 
 // MultiCharXor xors a slice of bytes using a multiple character repeating key
@@ -63,12 +124,20 @@ func MultiCharXor(encStr []byte, k []byte) []byte {
 // This is a naive brute force approach that looks at the output text and checks its statistical validity
 // as English text (using spaces).
 func MostLikelyXorKey(cypherBlock []byte) byte {
-	bestScore := 0.0
+	return MostLikelyXorKeyWithScorer(cypherBlock, &EnglishScorer{WithSpace: true})
+}
+
+// MostLikelyXorKeyWithScorer is like MostLikelyXorKey but uses the passed scorer
+// to check the statistical validity of the output text.
+// The cypher block usually is a column of a repeating key xor, so the char map
+// is always scored, even when the scorer also implements TextScorer.
+func MostLikelyXorKeyWithScorer(cypherBlock []byte, scorer CharMapScorer) byte {
+	bestScore := math.Inf(-1)
 	var winnerK byte
-	for k := 0; k < 255; k++ {
+	for k := 0; k < 256; k++ {
 		data := SingleCharXor(cypherBlock, byte(k))
 		cMap := NewCharMap(data)
-		score := cMap.EnglishScore(true)
+		score := scorer.Score(cMap)
 		if score >= bestScore {
 			// give a preference to ascii letters
 			if score == bestScore && IsASCIILetter(winnerK) {
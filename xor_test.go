@@ -2,7 +2,9 @@ package kripto
 
 import (
 	"bytes"
+	"encoding/hex"
 	"io/ioutil"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -41,6 +43,13 @@ func TestBreakSingleCharXor(t *testing.T) {
 			nil,
 			'M',
 		},
+		// last possible key
+		{
+			string(SingleCharXor([]byte("Crypto is fun, isn't it?"), 0xFF)),
+			"Crypto is fun, isn't it?",
+			nil,
+			0xFF,
+		},
 	}
 
 	scorer := &EnglishScorer{WithSpace: true}
@@ -52,6 +61,59 @@ func TestBreakSingleCharXor(t *testing.T) {
 	}
 }
 
+func TestBreakSingleCharXorCandidates(t *testing.T) {
+	input := "1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736"
+	scorer := &EnglishScorer{WithSpace: true}
+
+	testCases := []struct {
+		n        int
+		expected int
+	}{
+		{0, 256},
+		{-1, 256},
+		{3, 3},
+		{300, 256},
+	}
+
+	for i, tc := range testCases {
+		t.Logf("test case %d\n", i)
+		stats := BreakSingleCharXorCandidates([]byte(input), DeHex, scorer, tc.n)
+		if len(stats) != tc.expected {
+			t.Fatalf("expected %d candidates, got %d", tc.expected, len(stats))
+		}
+		if stats[0].Key != 'X' || string(stats[0].Text) != "Cooking MC's like a pound of bacon" {
+			t.Fatalf("unexpected winner\n%s", stats[:1])
+		}
+		for j := 1; j < len(stats); j++ {
+			if stats[j].Score > stats[j-1].Score {
+				t.Fatalf("candidates aren't ranked\n%s", stats)
+			}
+		}
+	}
+}
+
+func TestDetectSingleCharXor(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	lines := make([][]byte, 60)
+	for i := range lines {
+		line := make([]byte, 30)
+		rnd.Read(line)
+		lines[i] = []byte(hex.EncodeToString(line))
+	}
+	lines[42] = []byte("7b5a4215415d544115415d5015455447414c155c46155f4058455c5b523f")
+
+	stats := DetectSingleCharXor(lines, DeHex, &EnglishScorer{WithSpace: true}, 3)
+	if len(stats) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(stats))
+	}
+	if stats[0].Line != 42 || stats[0].Key != '5' || string(stats[0].Text) != "Now that the party is jumping\n" {
+		t.Fatalf("unexpected winner\n%s", stats)
+	}
+	if stats[1].Score > stats[0].Score || stats[2].Score > stats[1].Score {
+		t.Fatalf("lines aren't ranked\n%s", stats)
+	}
+}
+
 func TestMultiCharXor(t *testing.T) {
 	testCases := []struct {
 		input  string
@@ -125,3 +187,32 @@ func TestMostLikelyXorKey(t *testing.T) {
 		}
 	}
 }
+
+func TestBreakSingleCharXorErr(t *testing.T) {
+	scorer := &EnglishScorer{WithSpace: true}
+	o, k, err := BreakSingleCharXorErr([]byte("1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736"), DecodeHex, scorer)
+	if err != nil || string(o) != "Cooking MC's like a pound of bacon" || k != 'X' {
+		t.Fatalf("expected to get 'Cooking MC's like a pound of bacon' using key X but got\n'%s'\nfrom key %s (%v)\n", o, string(k), err)
+	}
+
+	_, _, err = BreakSingleCharXorErr([]byte("1b3737333136zf78"), DecodeHex, scorer)
+	if de, ok := err.(*DecodeError); !ok || de.Offset != 12 {
+		t.Fatalf("expected a decode error at offset 12 but got %v\n", err)
+	}
+}
+
+// negativeScorer shifts the scores of its scorer below zero, like distance based scorers.
+type negativeScorer struct {
+	CharMapScorer
+}
+
+func (s negativeScorer) Score(m *CharUseMap) float64 {
+	return s.CharMapScorer.Score(m) - 1e6
+}
+
+func TestMostLikelyXorKeyWithScorerNegativeScores(t *testing.T) {
+	data := SingleCharXor([]byte("Cooking MC's like a pound of bacon"), 'X')
+	if k := MostLikelyXorKeyWithScorer(data, negativeScorer{&EnglishScorer{WithSpace: true}}); k != 'X' {
+		t.Fatalf("expected key X\ngot\n%s\n", string(k))
+	}
+}